@@ -1,8 +1,20 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"log"
 
@@ -21,6 +33,17 @@ func main() {
 	// 加入命令行解析,如果有-h参数,打印帮助信息
 	// 如果有 -g 参数,则生成静态网页
 	// 如果没有参数,则启动服务器
+	// 如果程序是 eb bundle 生成的,直接使用其中的博客启动服务器
+	if exe, err := os.Executable(); err == nil {
+		bundle, err := OpenBundle(exe)
+		if err != nil {
+			log.Fatal("[bundle] open bundle failed:", err)
+		}
+		if bundle != nil {
+			ServeBundle(bundle, os.Args[1:])
+			return
+		}
+	}
 	if len(os.Args) < 2 {
 		os.Args = append(os.Args, "-s")
 	}
@@ -33,6 +56,12 @@ func main() {
 		Serve(pkg.LoadConfig("eb.toml"))
 	case "-v":
 		Version()
+	case "share":
+		Share(pkg.LoadConfig("eb.toml"), os.Args[2:])
+	case "check":
+		Check(pkg.LoadConfig("eb.toml"), os.Args[2:])
+	case "bundle":
+		BuildBundle(pkg.LoadConfig("eb.toml"), os.Args[2:])
 	default:
 		fmt.Println("unknown command")
 	}
@@ -57,16 +86,180 @@ func New() {
 func Serve(config *Config) {
 	r := gin.Default()
 	r.Use(cors.Default())
-	spider := fspider.NewSpider()
+	blogFS, err := OpenBlogFS(config)
+	if err != nil {
+		log.Fatal("[config] open blog failed:", err)
+	}
+	// a blog not on disk never changes, nothing to watch
+	var spider fspider.Spider
+	if blogFS == nil {
+		spider = fspider.NewSpider()
+		spider.Spide(config.BLOG_PATH)
+	} else {
+		spider = NewFSSpider(blogFS, config.BLOG_PATH)
+	}
 	defer spider.Stop()
-	spider.Spide(config.BLOG_PATH)
-	internal.RouteApp(r, config, spider)
+	shutdown := internal.RouteApp(r, config, spider, blogFS)
+	defer shutdown()
 	port := fmt.Sprintf(":%d", config.PORT)
-	if err := r.Run(port); err != nil {
-		log.Fatal(err)
+	// live reload streams never end by themselves, end them when shutting down instead of waiting for the timeout
+	streams, endStreams := context.WithCancel(context.Background())
+	srv := &http.Server{Addr: port, Handler: r, BaseContext: func(net.Listener) context.Context { return streams }}
+	srv.RegisterOnShutdown(endStreams)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+	// stop server gracefully, so that plugins and indexer can be closed
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+	log.Println("shutting down server...")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Println("server shutdown:", err)
 	}
 }
 
 func Version() {
 	fmt.Println(string(DEFAULT_VERSION))
 }
+
+func Share(config *Config, args []string) {
+	flags := flag.NewFlagSet("share", flag.ExitOnError)
+	expire := flags.Duration("e", 24*time.Hour, "expire duration of the link")
+	views := flags.Int("n", 0, "max views of the link, 0 means unlimited")
+	list := flags.Bool("l", false, "list outstanding links")
+	rotate := flags.Bool("rotate", false, "rotate the signing key, revoke all links")
+	flags.Parse(args)
+	shares := NewShareLinker(config.APP_DATA_PATH)
+	host := fmt.Sprintf("http://localhost:%d", config.PORT)
+	switch {
+	case *rotate:
+		if err := shares.Rotate(); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("all share links revoked")
+	case *list:
+		links, err := shares.List()
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, link := range links {
+			fmt.Printf("%s\t%s\t%d/%d\t%s\n", link.ID, link.Expires.Format(time.RFC3339), link.Views, link.MaxViews, host+link.String())
+		}
+	default:
+		url := flags.Arg(0)
+		if url == "" {
+			log.Fatal("usage: eb share [-e 24h] [-n 0] <url>")
+		}
+		if !strings.HasPrefix(url, config.BLOG_ROUTER+"/") {
+			url = config.BLOG_ROUTER + "/" + strings.TrimPrefix(url, "/")
+		}
+		link, err := shares.Create(url, *expire, *views)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(host + link.String())
+	}
+}
+
+func ServeBundle(bundle *Bundle, args []string) {
+	flags := flag.NewFlagSet("bundle", flag.ExitOnError)
+	port := flags.Int("p", bundle.Config.PORT, "port to listen on")
+	flags.Parse(args)
+	bundle.Config.PORT = *port
+	bundle.Use()
+	Serve(bundle.Config)
+}
+
+// 把博客、渲染好的页面和搜索内容追加到 eb 后面,得到一个不依赖 pandoc 和磁盘文件的程序
+func BuildBundle(config *Config, args []string) {
+	flags := flag.NewFlagSet("bundle", flag.ExitOnError)
+	output := flags.String("o", "eb-bundle", "output executable")
+	flags.Parse(args)
+	if config.BLOG_FS != BLOG_FS_OS {
+		log.Fatal("[bundle] bundle needs blog_fs = \"os\"")
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		log.Fatal(err)
+	}
+	loader, paths := loadOfflineLoader(config)
+	if git := NewGitMeta(config.BLOG_PATH, config.APP_DATA_PATH); git != nil {
+		loader.Git = git
+	}
+	if config.RELATED_NUM > 0 {
+		related := NewRelatedIndex(config.BLOG_PATH, config.BLOG_ROUTER, loader.Hide, loader.Private, config.RELATED_NUM)
+		related.Build(paths)
+		loader.Related = related
+	}
+	if err := WriteBundle(*output, exe, loader, config); err != nil {
+		log.Fatal("[bundle] ", err)
+	}
+	fmt.Println(*output)
+}
+
+// 不启动服务器时读取磁盘上的博客,返回 loader 和博客中的所有路径
+func loadOfflineLoader(config *Config) (*BlogLoader, []string) {
+	hide := NewBlogIgnorer().AddPatterns(config.HIDE_PATHS...)
+	private := NewBlogIgnorer().AddPatterns(config.PRIVATE_PATHS...)
+	if err := LoadIgnoreFiles(config.BLOG_PATH, hide, private, config.HONOR_GITIGNORE); err != nil {
+		log.Fatal(err)
+	}
+	var paths []string
+	if err := filepath.WalkDir(config.BLOG_PATH, func(path string, d fs.DirEntry, err error) error {
+		paths = append(paths, path)
+		return err
+	}); err != nil {
+		log.Fatal(err)
+	}
+	links := NewLinkGraph(config.BLOG_PATH, config.BLOG_ROUTER, hide, private)
+	links.Build(paths)
+	return &BlogLoader{
+		RWMutex:         &sync.RWMutex{},
+		BlogPath:        config.BLOG_PATH,
+		BlogRouter:      config.BLOG_ROUTER,
+		TemplatePath:    config.TEMPLATE_PATH,
+		RenderCommand:   config.RENDER_COMMAND,
+		FragmentCommand: config.FRAGMENT_COMMAND,
+		SymlinkPolicy:   config.SYMLINK_POLICY,
+		DirPageSize:     config.DIR_PAGE_SIZE,
+		Hide:            hide,
+		Private:         private,
+		Links:           links,
+	}, paths
+}
+
+func Check(config *Config, args []string) {
+	if len(args) == 0 || args[0] != "links" {
+		log.Fatal("usage: eb check links [-external] [-json]")
+	}
+	flags := flag.NewFlagSet("check links", flag.ExitOnError)
+	external := flags.Bool("external", false, "also probe external links")
+	asJson := flags.Bool("json", false, "print issues as json")
+	flags.Parse(args[1:])
+	loader, _ := loadOfflineLoader(config)
+	var client *http.Client
+	if *external {
+		timeout, _ := time.ParseDuration(config.CHECK_TIMEOUT)
+		client = &http.Client{Timeout: timeout}
+	}
+	issues, err := NewLinkChecker(loader, client, config.CHECK_USER_AGENT).Check()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *asJson {
+		data, _ := json.MarshalIndent(issues, "", "  ")
+		fmt.Println(string(data))
+	} else {
+		for _, issue := range issues {
+			fmt.Println(issue)
+		}
+	}
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+}
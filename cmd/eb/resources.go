@@ -1,11 +1,11 @@
 package main
 
-var DEFAULT_CONFIG = []byte{112,111,114,116,32,61,32,55,55,55,55,13,10,98,108,111,103,95,112,97,116,104,32,61,32,34,46,47,98,108,111,103,34,13,10,103,101,110,95,112,97,116,104,32,61,32,34,46,47,103,101,110,34,13,10,110,111,116,95,103,101,110,32,61,32,116,114,117,101,13,10,104,105,100,101,95,112,97,116,104,115,32,61,32,91,13,10,34,42,46,106,115,34,44,13,10,34,42,46,105,99,111,34,44,13,10,34,98,108,111,103,47,104,105,100,101,46,109,100,34,44,13,10,93,13,10,116,101,109,112,108,97,116,101,95,112,97,116,104,32,61,32,34,46,47,116,101,109,112,108,97,116,101,46,104,116,109,108,34,13,10,97,112,112,95,100,97,116,97,95,112,97,116,104,32,61,32,34,126,47,46,101,98,34,13,10,115,101,97,114,99,104,95,110,117,109,32,61,32,49,51,13,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,13,10,110,97,109,101,32,61,32,34,107,101,121,119,111,114,100,34,13,10,98,114,105,101,102,32,61,32,34,229,133,179,233,148,174,232,175,141,230,144,156,231,180,162,34,13,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,13,10,100,105,115,97,98,108,101,32,61,32,116,114,117,101,13,10,13,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,13,10,110,97,109,101,32,61,32,34,99,111,110,116,101,110,116,34,13,10,98,114,105,101,102,32,61,32,34,229,134,133,229,174,185,230,144,156,231,180,162,34,13,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,13,10,100,105,115,97,98,108,101,32,61,32,116,114,117,101,13,10,13,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,13,10,110,97,109,101,32,61,32,34,102,122,102,95,102,100,34,13,10,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,102,122,102,43,102,100,230,144,156,231,180,162,34,13,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,13,10,99,111,109,109,97,110,100,32,61,32,34,102,100,32,46,32,36,123,66,76,79,71,95,80,65,84,72,125,32,124,32,102,122,102,32,45,102,32,36,123,75,69,89,95,87,79,82,68,125,32,124,32,104,101,97,100,32,45,110,32,36,123,78,85,77,125,34,13,10,13,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,13,10,110,97,109,101,32,61,32,34,114,105,112,95,99,111,110,116,101,110,116,34,13,10,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,114,105,112,103,114,101,112,229,140,185,233,133,141,230,150,135,228,187,182,229,134,133,229,174,185,230,144,156,231,180,162,34,13,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,13,10,99,111,109,109,97,110,100,32,61,32,34,114,103,32,36,123,75,69,89,95,87,79,82,68,125,32,36,123,66,76,79,71,95,80,65,84,72,125,32,45,108,124,32,104,101,97,100,32,45,110,32,36,123,78,85,77,125,34,13,10,13,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,13,10,110,97,109,101,32,61,32,34,114,105,112,95,102,100,95,112,97,116,104,34,13,10,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,114,105,112,103,114,101,112,43,102,100,233,146,136,229,175,185,230,150,135,228,187,182,232,183,175,229,190,132,230,144,156,231,180,162,34,13,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,13,10,99,111,109,109,97,110,100,32,61,32,34,102,100,32,46,32,36,123,66,76,79,71,95,80,65,84,72,125,32,124,32,114,103,32,36,123,75,69,89,95,87,79,82,68,125,32,124,32,104,101,97,100,32,45,110,32,36,123,78,85,77,125,34,13,10,13,10,}
-var DEFAULT_TEMPLATE = []byte{60,33,68,79,67,84,89,80,69,32,104,116,109,108,62,13,10,60,104,116,109,108,62,13,10,13,10,60,104,101,97,100,62,13,10,32,32,32,32,60,109,101,116,97,32,99,104,97,114,115,101,116,61,34,117,116,102,45,56,34,62,13,10,32,32,32,32,60,116,105,116,108,101,62,36,116,105,116,108,101,36,60,47,116,105,116,108,101,62,13,10,32,32,32,32,60,115,99,114,105,112,116,32,115,114,99,61,34,47,98,108,111,103,47,118,117,101,46,106,115,34,62,60,47,115,99,114,105,112,116,62,13,10,32,32,32,32,60,115,116,121,108,101,62,13,10,32,32,32,32,32,32,32,32,46,99,111,110,116,97,105,110,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,102,108,101,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,45,100,105,114,101,99,116,105,111,110,58,32,99,111,108,117,109,110,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,49,48,48,118,104,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,105,100,116,104,58,32,49,50,48,37,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,98,97,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,111,112,58,32,53,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,111,115,105,116,105,111,110,58,32,102,105,120,101,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,53,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,105,100,116,104,58,32,54,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,101,102,116,58,32,53,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,114,97,110,115,102,111,114,109,58,32,116,114,97,110,115,108,97,116,101,88,40,45,53,48,37,41,59,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,102,108,101,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,49,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,45,98,97,115,105,115,58,32,49,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,108,105,103,110,45,105,116,101,109,115,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,106,117,115,116,105,102,121,45,99,111,110,116,101,110,116,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,105,110,112,117,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,54,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,98,117,116,116,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,108,101,102,116,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,117,114,115,111,114,58,32,112,111,105,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,116,121,112,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,108,101,102,116,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,117,114,115,111,114,58,32,112,111,105,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,110,117,109,98,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,108,101,102,116,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,117,114,115,111,114,58,32,112,111,105,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,99,111,110,116,101,110,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,116,111,112,58,32,49,48,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,57,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,111,118,101,114,102,108,111,119,45,121,58,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,60,47,115,116,121,108,101,62,13,10,32,32,32,32,60,115,116,121,108,101,62,13,10,32,32,32,32,32,32,32,32,104,116,109,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,111,118,101,114,102,108,111,119,45,121,58,32,115,99,114,111,108,108,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,45,119,101,98,107,105,116,45,116,101,120,116,45,115,105,122,101,45,97,100,106,117,115,116,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,45,109,115,45,116,101,120,116,45,115,105,122,101,45,97,100,106,117,115,116,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,13,10,32,32,32,32,32,32,32,32,112,114,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,48,54,48,52,48,52,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,114,97,100,105,117,115,58,32,49,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,45,99,111,108,111,114,58,32,35,70,53,70,53,70,53,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,52,52,52,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,102,97,109,105,108,121,58,32,71,101,111,114,103,105,97,44,32,80,97,108,97,116,105,110,111,44,32,226,128,152,80,97,108,97,116,105,110,111,32,76,105,110,111,116,121,112,101,226,128,152,44,32,84,105,109,101,115,44,32,226,128,152,84,105,109,101,115,32,78,101,119,32,82,111,109,97,110,226,128,152,44,32,115,101,114,105,102,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,50,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,105,110,101,45,104,101,105,103,104,116,58,32,49,46,55,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,52,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,35,102,101,102,101,102,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,52,53,97,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,100,101,99,111,114,97,116,105,111,110,58,32,110,111,110,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,118,105,115,105,116,101,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,98,48,48,56,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,104,111,118,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,97,99,116,105,118,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,102,97,97,55,48,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,102,111,99,117,115,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,111,117,116,108,105,110,101,58,32,116,104,105,110,32,100,111,116,116,101,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,42,58,58,45,109,111,122,45,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,42,58,58,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,58,45,109,111,122,45,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,52,53,97,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,58,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,52,53,97,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,49,44,13,10,32,32,32,32,32,32,32,32,104,50,44,13,10,32,32,32,32,32,32,32,32,104,51,44,13,10,32,32,32,32,32,32,32,32,104,52,44,13,10,32,32,32,32,32,32,32,32,104,53,44,13,10,32,32,32,32,32,32,32,32,104,54,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,49,49,49,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,105,110,101,45,104,101,105,103,104,116,58,32,49,50,53,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,116,111,112,58,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,110,111,114,109,97,108,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,52,44,13,10,32,32,32,32,32,32,32,32,104,53,44,13,10,32,32,32,32,32,32,32,32,104,54,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,49,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,50,46,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,50,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,51,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,46,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,52,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,46,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,53,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,54,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,48,46,57,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,98,108,111,99,107,113,117,111,116,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,54,54,54,54,54,54,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,45,108,101,102,116,58,32,51,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,108,101,102,116,58,32,48,46,53,101,109,32,35,69,69,69,32,115,111,108,105,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,98,108,111,99,107,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,50,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,116,111,112,58,32,49,112,120,32,115,111,108,105,100,32,35,97,97,97,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,35,101,101,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,112,114,101,44,13,10,32,32,32,32,32,32,32,32,99,111,100,101,44,13,10,32,32,32,32,32,32,32,32,107,98,100,44,13,10,32,32,32,32,32,32,32,32,115,97,109,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,102,97,109,105,108,121,58,32,109,111,110,111,115,112,97,99,101,44,32,109,111,110,111,115,112,97,99,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,95,102,111,110,116,45,102,97,109,105,108,121,58,32,226,128,152,99,111,117,114,105,101,114,32,110,101,119,226,128,152,44,32,109,111,110,111,115,112,97,99,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,48,46,57,56,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,112,114,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,104,105,116,101,45,115,112,97,99,101,58,32,112,114,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,104,105,116,101,45,115,112,97,99,101,58,32,112,114,101,45,119,114,97,112,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,111,114,100,45,119,114,97,112,58,32,98,114,101,97,107,45,119,111,114,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,98,44,13,10,32,32,32,32,32,32,32,32,115,116,114,111,110,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,102,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,116,121,108,101,58,32,105,116,97,108,105,99,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,105,110,115,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,35,102,102,57,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,100,101,99,111,114,97,116,105,111,110,58,32,110,111,110,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,109,97,114,107,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,35,102,102,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,116,121,108,101,58,32,105,116,97,108,105,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,115,117,98,44,13,10,32,32,32,32,32,32,32,32,115,117,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,55,53,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,105,110,101,45,104,101,105,103,104,116,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,111,115,105,116,105,111,110,58,32,114,101,108,97,116,105,118,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,118,101,114,116,105,99,97,108,45,97,108,105,103,110,58,32,98,97,115,101,108,105,110,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,115,117,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,111,112,58,32,45,48,46,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,115,117,98,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,116,116,111,109,58,32,45,48,46,50,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,117,108,44,13,10,32,32,32,32,32,32,32,32,111,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,48,32,48,32,48,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,108,105,32,112,58,108,97,115,116,45,99,104,105,108,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,117,108,32,117,108,44,13,10,32,32,32,32,32,32,32,32,111,108,32,111,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,46,51,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,46,56,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,32,48,32,46,56,101,109,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,100,58,108,97,115,116,45,99,104,105,108,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,45,109,115,45,105,110,116,101,114,112,111,108,97,116,105,111,110,45,109,111,100,101,58,32,98,105,99,117,98,105,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,118,101,114,116,105,99,97,108,45,97,108,105,103,110,58,32,109,105,100,100,108,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,102,105,103,117,114,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,98,108,111,99,107,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,97,108,105,103,110,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,102,105,103,117,114,101,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,110,111,110,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,102,105,103,99,97,112,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,48,46,56,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,116,121,108,101,58,32,105,116,97,108,105,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,32,48,32,46,56,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,116,97,98,108,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,114,105,103,104,116,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,115,112,97,99,105,110,103,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,99,111,108,108,97,112,115,101,58,32,99,111,108,108,97,112,115,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,116,97,98,108,101,32,116,104,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,46,50,101,109,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,45,99,111,108,111,114,58,32,35,101,101,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,116,111,112,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,108,101,102,116,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,116,97,98,108,101,32,116,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,46,50,101,109,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,116,111,112,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,108,101,102,116,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,118,101,114,116,105,99,97,108,45,97,108,105,103,110,58,32,116,111,112,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,97,117,116,104,111,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,46,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,97,108,105,103,110,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,64,109,101,100,105,97,32,111,110,108,121,32,115,99,114,101,101,110,32,97,110,100,32,40,109,105,110,45,119,105,100,116,104,58,32,52,56,48,112,120,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,52,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,64,109,101,100,105,97,32,111,110,108,121,32,115,99,114,101,101,110,32,97,110,100,32,40,109,105,110,45,119,105,100,116,104,58,32,55,54,56,112,120,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,54,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,64,109,101,100,105,97,32,112,114,105,110,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,42,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,116,114,97,110,115,112,97,114,101,110,116,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,98,108,97,99,107,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,105,108,116,101,114,58,32,110,111,110,101,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,45,109,115,45,102,105,108,116,101,114,58,32,110,111,110,101,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,50,112,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,58,118,105,115,105,116,101,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,100,101,99,111,114,97,116,105,111,110,58,32,117,110,100,101,114,108,105,110,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,49,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,98,108,97,99,107,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,91,104,114,101,102,93,58,97,102,116,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,34,32,40,34,32,97,116,116,114,40,104,114,101,102,41,32,34,41,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,98,98,114,91,116,105,116,108,101,93,58,97,102,116,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,34,32,40,34,32,97,116,116,114,40,116,105,116,108,101,41,32,34,41,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,46,105,114,32,97,58,97,102,116,101,114,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,91,104,114,101,102,94,61,34,106,97,118,97,115,99,114,105,112,116,58,34,93,58,97,102,116,101,114,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,91,104,114,101,102,94,61,34,35,34,93,58,97,102,116,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,34,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,114,101,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,108,111,99,107,113,117,111,116,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,57,57,57,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,45,114,105,103,104,116,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,103,101,45,98,114,101,97,107,45,105,110,115,105,100,101,58,32,97,118,111,105,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,114,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,103,101,45,98,114,101,97,107,45,105,110,115,105,100,101,58,32,97,118,111,105,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,49,48,48,37,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,64,112,97,103,101,32,58,108,101,102,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,53,109,109,32,50,48,109,109,32,49,53,109,109,32,49,48,109,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,64,112,97,103,101,32,58,114,105,103,104,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,53,109,109,32,49,48,109,109,32,49,53,109,109,32,50,48,109,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,50,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,51,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,111,114,112,104,97,110,115,58,32,51,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,119,105,100,111,119,115,58,32,51,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,50,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,51,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,103,101,45,98,114,101,97,107,45,97,102,116,101,114,58,32,97,118,111,105,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,60,47,115,116,121,108,101,62,13,10,60,47,104,101,97,100,62,13,10,13,10,60,98,111,100,121,62,13,10,32,32,32,32,60,100,105,118,32,105,100,61,34,97,112,112,34,62,13,10,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,99,111,110,116,97,105,110,101,114,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,98,97,114,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,105,110,112,117,116,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,105,110,112,117,116,34,32,118,45,109,111,100,101,108,61,34,107,101,121,119,111,114,100,34,32,116,121,112,101,61,34,116,101,120,116,34,32,112,108,97,99,101,104,111,108,100,101,114,61,34,232,175,183,232,190,147,229,133,165,229,133,179,233,148,174,232,175,141,34,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,64,107,101,121,100,111,119,110,46,101,110,116,101,114,61,34,112,101,114,102,111,114,109,83,101,97,114,99,104,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,98,117,116,116,111,110,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,98,117,116,116,111,110,34,32,64,99,108,105,99,107,61,34,112,101,114,102,111,114,109,83,101,97,114,99,104,34,62,83,101,97,114,99,104,60,47,98,117,116,116,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,115,101,108,101,99,116,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,116,121,112,101,34,32,118,45,109,111,100,101,108,61,34,115,101,97,114,99,104,84,121,112,101,34,32,64,99,104,97,110,103,101,61,34,115,97,118,101,80,114,101,102,101,114,101,110,99,101,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,33,45,45,32,228,189,191,231,148,168,118,45,102,111,114,230,140,135,228,187,164,229,174,158,231,142,176,32,45,45,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,111,112,116,105,111,110,32,118,45,102,111,114,61,34,115,101,97,114,99,104,101,114,32,105,110,32,115,101,97,114,99,104,101,114,115,34,32,58,107,101,121,61,34,115,101,97,114,99,104,101,114,46,116,121,112,101,34,32,58,118,97,108,117,101,61,34,115,101,97,114,99,104,101,114,46,116,121,112,101,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,123,123,32,115,101,97,114,99,104,101,114,46,98,114,105,101,102,32,125,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,111,112,116,105,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,33,45,45,32,60,111,112,116,105,111,110,32,118,97,108,117,101,61,34,107,101,121,119,111,114,100,34,62,229,133,179,233,148,174,232,175,141,230,144,156,231,180,162,60,47,111,112,116,105,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,60,111,112,116,105,111,110,32,118,97,108,117,101,61,34,116,105,116,108,101,34,32,62,229,159,186,228,186,142,230,160,135,233,162,152,231,154,132,230,150,135,230,156,172,232,183,157,231,166,187,230,144,156,231,180,162,60,47,111,112,116,105,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,60,111,112,116,105,111,110,32,118,97,108,117,101,61,34,99,111,110,116,101,110,116,34,62,230,150,135,231,171,160,229,134,133,229,174,185,229,140,185,233,133,141,230,144,156,231,180,162,60,47,111,112,116,105,111,110,62,32,45,45,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,115,101,108,101,99,116,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,105,110,112,117,116,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,110,117,109,98,101,114,34,32,116,121,112,101,61,34,110,117,109,98,101,114,34,32,118,45,109,111,100,101,108,61,34,115,101,97,114,99,104,78,117,109,34,32,105,100,61,34,113,117,97,110,116,105,116,121,34,32,110,97,109,101,61,34,113,117,97,110,116,105,116,121,34,32,109,105,110,61,34,49,34,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,61,34,49,48,48,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,99,111,110,116,101,110,116,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,118,45,105,102,61,34,105,115,76,111,97,100,105,110,103,34,62,76,111,97,100,105,110,103,46,46,46,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,118,45,105,102,61,34,105,115,66,97,115,101,34,62,32,36,116,111,99,36,32,60,98,114,62,60,98,114,62,32,36,98,111,100,121,36,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,118,45,101,108,115,101,32,118,45,104,116,109,108,61,34,99,111,110,116,101,110,116,34,62,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,60,47,100,105,118,62,13,10,13,10,32,32,32,32,60,115,99,114,105,112,116,62,13,10,32,32,32,32,32,32,32,32,99,111,110,115,116,32,97,112,112,32,61,32,86,117,101,46,99,114,101,97,116,101,65,112,112,40,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,97,116,97,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,114,101,116,117,114,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,107,101,121,119,111,114,100,58,32,39,39,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,105,115,76,111,97,100,105,110,103,58,32,102,97,108,115,101,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,105,115,66,97,115,101,58,32,116,114,117,101,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,39,39,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,78,117,109,58,32,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,78,117,109,39,41,32,124,124,32,49,48,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,84,121,112,101,58,32,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,84,121,112,101,39,41,32,124,124,32,39,116,105,116,108,101,39,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,101,114,115,58,32,74,83,79,78,46,112,97,114,115,101,40,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,101,114,115,39,41,41,32,124,124,32,91,93,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,114,101,97,116,101,100,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,105,102,32,40,33,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,101,114,115,39,41,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,102,101,116,99,104,83,101,97,114,99,104,101,114,115,40,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,115,101,97,114,99,104,101,114,115,82,101,102,114,101,115,104,73,110,116,101,114,118,97,108,73,100,32,61,32,115,101,116,73,110,116,101,114,118,97,108,40,116,104,105,115,46,102,101,116,99,104,83,101,97,114,99,104,101,114,115,44,32,49,48,48,48,48,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,101,102,111,114,101,85,110,109,111,117,110,116,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,108,101,97,114,73,110,116,101,114,118,97,108,40,116,104,105,115,46,115,101,97,114,99,104,101,114,115,82,101,102,114,101,115,104,73,110,116,101,114,118,97,108,73,100,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,101,116,104,111,100,115,58,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,101,116,99,104,83,101,97,114,99,104,101,114,115,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,114,101,116,117,114,110,32,102,101,116,99,104,40,34,47,97,112,105,47,115,101,97,114,99,104,101,114,115,34,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,114,101,115,112,111,110,115,101,32,61,62,32,114,101,115,112,111,110,115,101,46,106,115,111,110,40,41,41,32,32,47,47,32,233,166,150,229,133,136,232,167,163,230,158,144,74,83,79,78,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,100,97,116,97,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,230,149,176,230,141,174,232,167,163,230,158,144,230,136,144,229,138,159,229,144,142,239,188,140,229,176,134,229,133,182,229,173,152,229,130,168,229,136,176,108,111,99,97,108,83,116,111,114,97,103,101,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,111,99,97,108,83,116,111,114,97,103,101,46,115,101,116,73,116,101,109,40,39,115,101,97,114,99,104,101,114,115,39,44,32,74,83,79,78,46,115,116,114,105,110,103,105,102,121,40,100,97,116,97,41,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,231,132,182,229,144,142,229,176,134,230,149,176,230,141,174,232,181,139,229,128,188,231,187,153,116,104,105,115,46,115,101,97,114,99,104,101,114,115,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,115,101,97,114,99,104,101,114,115,32,61,32,100,97,116,97,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,99,97,116,99,104,40,101,114,114,111,114,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,111,108,101,46,101,114,114,111,114,40,39,230,144,156,231,180,162,232,175,183,230,177,130,229,164,177,232,180,165,58,39,44,32,101,114,114,111,114,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,101,114,102,111,114,109,83,101,97,114,99,104,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,76,111,97,100,105,110,103,32,61,32,116,114,117,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,66,97,115,101,32,61,32,102,97,108,115,101,59,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,230,158,132,233,128,160,230,144,156,231,180,162,232,175,183,230,177,130,231,154,132,32,85,82,76,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,116,32,107,101,121,119,111,114,100,32,61,32,116,104,105,115,46,107,101,121,119,111,114,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,116,32,117,114,108,32,61,32,34,47,97,112,105,47,115,101,97,114,99,104,63,107,101,121,119,111,114,100,61,34,32,43,32,101,110,99,111,100,101,85,82,73,67,111,109,112,111,110,101,110,116,40,107,101,121,119,111,114,100,41,32,43,32,34,38,115,101,97,114,99,104,84,121,112,101,61,34,32,43,32,116,104,105,115,46,115,101,97,114,99,104,84,121,112,101,32,43,32,34,38,110,117,109,61,34,32,43,32,116,104,105,115,46,115,101,97,114,99,104,78,117,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,111,108,101,46,108,111,103,40,117,114,108,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,229,138,160,229,133,165,230,144,156,231,180,162,231,177,187,229,158,139,229,143,130,230,149,176,32,115,101,97,114,99,104,116,121,112,101,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,229,143,145,233,128,129,231,189,145,231,187,156,232,175,183,230,177,130,232,142,183,229,143,150,233,147,190,230,142,165,230,149,176,231,187,132,231,154,132,32,74,83,79,78,32,229,147,141,229,186,148,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,80,114,111,109,105,115,101,46,114,97,99,101,40,91,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,101,116,99,104,40,117,114,108,41,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,110,101,119,32,80,114,111,109,105,115,101,40,40,114,101,115,111,108,118,101,44,32,114,101,106,101,99,116,41,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,116,84,105,109,101,111,117,116,40,40,41,32,61,62,32,114,101,106,101,99,116,40,110,101,119,32,69,114,114,111,114,40,39,232,175,183,230,177,130,232,182,133,230,151,182,39,41,41,44,32,53,48,48,48,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,93,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,114,101,115,112,111,110,115,101,32,61,62,32,114,101,115,112,111,110,115,101,46,106,115,111,110,40,41,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,100,97,116,97,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,99,111,110,116,101,110,116,32,61,32,116,104,105,115,46,103,101,110,101,114,97,116,101,76,105,110,107,76,105,115,116,40,100,97,116,97,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,76,111,97,100,105,110,103,32,61,32,102,97,108,115,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,99,97,116,99,104,40,101,114,114,111,114,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,111,108,101,46,101,114,114,111,114,40,39,230,144,156,231,180,162,232,175,183,230,177,130,229,164,177,232,180,165,58,39,44,32,101,114,114,111,114,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,76,111,97,100,105,110,103,32,61,32,102,97,108,115,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,103,101,110,101,114,97,116,101,76,105,110,107,76,105,115,116,40,108,105,110,107,65,114,114,97,121,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,101,116,32,104,116,109,108,32,61,32,39,60,117,108,62,39,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,114,32,40,108,101,116,32,105,32,61,32,48,59,32,105,32,60,32,108,105,110,107,65,114,114,97,121,46,108,101,110,103,116,104,59,32,105,43,43,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,116,32,117,114,108,32,61,32,108,105,110,107,65,114,114,97,121,91,105,93,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,104,116,109,108,32,43,61,32,34,60,108,105,62,60,97,32,104,114,101,102,61,92,34,34,32,43,32,117,114,108,32,43,32,34,92,34,62,34,32,43,32,117,114,108,32,43,32,34,60,47,97,62,60,47,108,105,62,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,104,116,109,108,32,43,61,32,39,60,47,117,108,62,39,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,114,101,116,117,114,110,32,104,116,109,108,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,97,118,101,80,114,101,102,101,114,101,110,99,101,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,111,99,97,108,83,116,111,114,97,103,101,46,115,101,116,73,116,101,109,40,39,115,101,97,114,99,104,84,121,112,101,39,44,32,116,104,105,115,46,115,101,97,114,99,104,84,121,112,101,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,97,116,99,104,58,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,230,175,143,229,189,147,115,101,97,114,99,104,78,117,109,230,148,185,229,143,152,230,151,182,239,188,140,233,131,189,229,176,134,229,133,182,228,191,157,229,173,152,229,136,176,108,111,99,97,108,83,116,111,114,97,103,101,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,78,117,109,40,110,101,119,86,97,108,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,111,99,97,108,83,116,111,114,97,103,101,46,115,101,116,73,116,101,109,40,39,115,101,97,114,99,104,78,117,109,39,44,32,110,101,119,86,97,108,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,125,41,59,13,10,13,10,32,32,32,32,32,32,32,32,97,112,112,46,109,111,117,110,116,40,39,35,97,112,112,39,41,59,13,10,32,32,32,32,60,47,115,99,114,105,112,116,62,13,10,60,47,98,111,100,121,62,13,10,13,10,60,47,104,116,109,108,62,}
+var DEFAULT_CONFIG = []byte{112,111,114,116,32,61,32,55,55,55,55,10,98,108,111,103,95,112,97,116,104,32,61,32,34,46,47,98,108,111,103,34,10,103,101,110,95,112,97,116,104,32,61,32,34,46,47,103,101,110,34,10,35,32,114,101,97,100,32,116,104,101,32,98,108,111,103,32,102,114,111,109,32,97,32,122,105,112,32,102,105,108,101,32,40,111,114,32,34,101,109,98,101,100,34,32,102,111,114,32,97,32,98,117,110,100,108,101,100,32,98,105,110,97,114,121,41,32,105,110,115,116,101,97,100,32,111,102,32,98,108,111,103,95,112,97,116,104,32,111,110,32,100,105,115,107,10,35,32,98,108,111,103,95,102,115,32,61,32,34,122,105,112,34,10,35,32,98,108,111,103,95,122,105,112,32,61,32,34,46,47,98,108,111,103,46,122,105,112,34,10,110,111,116,95,103,101,110,32,61,32,116,114,117,101,10,35,32,97,108,115,111,32,119,114,105,116,101,32,46,103,122,47,46,98,114,32,110,101,120,116,32,116,111,32,103,101,110,101,114,97,116,101,100,32,102,105,108,101,115,10,35,32,103,101,110,95,99,111,109,112,114,101,115,115,32,61,32,91,34,103,122,105,112,34,44,32,34,98,114,34,93,10,35,32,108,111,103,32,98,114,111,107,101,110,32,108,105,110,107,115,32,111,102,32,103,101,110,101,114,97,116,101,100,32,112,97,103,101,115,10,35,32,99,104,101,99,107,95,108,105,110,107,115,32,61,32,116,114,117,101,10,35,32,108,105,115,116,32,117,112,32,116,111,32,116,104,105,115,32,109,97,110,121,32,114,101,108,97,116,101,100,32,112,111,115,116,115,32,97,116,32,116,104,101,32,101,110,100,32,111,102,32,101,97,99,104,32,112,111,115,116,10,35,32,114,101,108,97,116,101,100,95,110,117,109,32,61,32,53,10,35,32,115,104,111,119,32,63,104,105,115,116,111,114,121,32,97,110,100,32,63,100,105,102,102,32,111,102,32,112,111,115,116,115,32,116,111,32,101,118,101,114,121,111,110,101,32,40,34,112,117,98,108,105,99,34,41,32,111,114,32,111,110,108,121,32,119,105,116,104,32,97,100,109,105,110,95,116,111,107,101,110,32,40,34,97,100,109,105,110,34,41,10,35,32,104,105,115,116,111,114,121,32,61,32,34,97,100,109,105,110,34,10,35,32,115,101,114,118,101,32,116,104,101,32,98,108,111,103,32,97,116,32,97,32,103,105,116,32,98,114,97,110,99,104,44,32,116,97,103,32,111,114,32,99,111,109,109,105,116,32,117,110,100,101,114,32,47,98,108,111,103,64,60,114,101,102,62,47,44,32,101,46,103,46,32,47,98,108,111,103,64,109,97,105,110,47,10,35,32,112,114,101,118,105,101,119,32,61,32,34,97,100,109,105,110,34,10,104,105,100,101,95,112,97,116,104,115,32,61,32,91,10,34,42,46,106,115,34,44,10,34,42,46,105,99,111,34,44,10,34,98,108,111,103,47,104,105,100,101,46,109,100,34,44,10,93,10,116,101,109,112,108,97,116,101,95,112,97,116,104,32,61,32,34,46,47,116,101,109,112,108,97,116,101,46,104,116,109,108,34,10,97,112,112,95,100,97,116,97,95,112,97,116,104,32,61,32,34,126,47,46,101,98,34,10,115,101,97,114,99,104,95,110,117,109,32,61,32,49,51,10,35,32,99,111,109,109,97,110,100,32,112,108,117,103,105,110,115,32,114,117,110,32,119,105,116,104,111,117,116,32,115,104,101,108,108,44,32,36,123,75,69,89,95,87,79,82,68,125,32,97,116,32,116,104,101,32,115,116,97,114,116,32,111,102,32,97,110,32,97,114,103,117,109,101,110,116,32,109,117,115,116,32,98,101,32,112,108,97,99,101,100,32,97,102,116,101,114,32,45,45,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,10,110,97,109,101,32,61,32,34,107,101,121,119,111,114,100,34,10,98,114,105,101,102,32,61,32,34,229,133,179,233,148,174,232,175,141,230,144,156,231,180,162,34,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,10,100,105,115,97,98,108,101,32,61,32,116,114,117,101,10,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,10,110,97,109,101,32,61,32,34,99,111,110,116,101,110,116,34,10,98,114,105,101,102,32,61,32,34,229,134,133,229,174,185,230,144,156,231,180,162,34,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,10,100,105,115,97,98,108,101,32,61,32,116,114,117,101,10,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,10,110,97,109,101,32,61,32,34,102,122,102,95,102,100,34,10,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,102,122,102,43,102,100,230,144,156,231,180,162,34,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,10,99,111,109,109,97,110,100,32,61,32,34,102,100,32,46,32,36,123,66,76,79,71,95,80,65,84,72,125,32,124,32,102,122,102,32,45,45,102,105,108,116,101,114,61,36,123,75,69,89,95,87,79,82,68,125,32,124,32,104,101,97,100,32,45,110,32,36,123,78,85,77,125,34,10,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,10,110,97,109,101,32,61,32,34,114,105,112,95,99,111,110,116,101,110,116,34,10,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,114,105,112,103,114,101,112,229,140,185,233,133,141,230,150,135,228,187,182,229,134,133,229,174,185,230,144,156,231,180,162,34,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,10,99,111,109,109,97,110,100,32,61,32,34,114,103,32,45,108,32,45,45,32,36,123,75,69,89,95,87,79,82,68,125,32,36,123,66,76,79,71,95,80,65,84,72,125,32,124,32,104,101,97,100,32,45,110,32,36,123,78,85,77,125,34,10,116,105,109,101,111,117,116,32,61,32,34,53,115,34,10,10,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,10,110,97,109,101,32,61,32,34,114,105,112,95,102,100,95,112,97,116,104,34,10,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,114,105,112,103,114,101,112,43,102,100,233,146,136,229,175,185,230,150,135,228,187,182,232,183,175,229,190,132,230,144,156,231,180,162,34,10,116,121,112,101,32,61,32,34,99,111,109,109,97,110,100,34,10,99,111,109,109,97,110,100,32,61,32,34,102,100,32,46,32,36,123,66,76,79,71,95,80,65,84,72,125,32,124,32,114,103,32,45,45,32,36,123,75,69,89,95,87,79,82,68,125,32,124,32,104,101,97,100,32,45,110,32,36,123,78,85,77,125,34,10,10,10,35,32,97,32,108,111,110,103,45,114,117,110,110,105,110,103,32,112,108,117,103,105,110,32,116,104,97,116,32,115,112,101,97,107,115,32,110,101,119,108,105,110,101,45,100,101,108,105,109,105,116,101,100,32,106,115,111,110,45,114,112,99,32,111,118,101,114,32,115,116,100,105,110,47,115,116,100,111,117,116,10,35,32,91,91,115,101,97,114,99,104,95,112,108,117,103,105,110,115,93,93,10,35,32,110,97,109,101,32,61,32,34,108,108,109,34,10,35,32,98,114,105,101,102,32,61,32,34,228,189,191,231,148,168,230,156,172,229,156,176,230,168,161,229,158,139,230,144,156,231,180,162,34,10,35,32,116,121,112,101,32,61,32,34,115,116,100,105,111,34,10,35,32,99,111,109,109,97,110,100,32,61,32,34,112,121,116,104,111,110,51,32,115,101,97,114,99,104,101,114,115,47,108,108,109,95,115,101,97,114,99,104,101,114,46,112,121,34,10,35,32,116,105,109,101,111,117,116,32,61,32,34,51,48,115,34,10,}
+var DEFAULT_TEMPLATE = []byte{60,33,68,79,67,84,89,80,69,32,104,116,109,108,62,13,10,60,104,116,109,108,62,13,10,13,10,60,104,101,97,100,62,13,10,32,32,32,32,60,109,101,116,97,32,99,104,97,114,115,101,116,61,34,117,116,102,45,56,34,62,13,10,32,32,32,32,60,116,105,116,108,101,62,36,116,105,116,108,101,36,60,47,116,105,116,108,101,62,13,10,32,32,32,32,60,115,99,114,105,112,116,32,115,114,99,61,34,47,98,108,111,103,47,118,117,101,46,106,115,34,62,60,47,115,99,114,105,112,116,62,13,10,32,32,32,32,60,115,116,121,108,101,62,13,10,32,32,32,32,32,32,32,32,46,99,111,110,116,97,105,110,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,102,108,101,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,45,100,105,114,101,99,116,105,111,110,58,32,99,111,108,117,109,110,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,49,48,48,118,104,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,105,100,116,104,58,32,49,50,48,37,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,98,97,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,111,112,58,32,53,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,111,115,105,116,105,111,110,58,32,102,105,120,101,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,53,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,105,100,116,104,58,32,54,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,101,102,116,58,32,53,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,114,97,110,115,102,111,114,109,58,32,116,114,97,110,115,108,97,116,101,88,40,45,53,48,37,41,59,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,102,108,101,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,49,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,45,98,97,115,105,115,58,32,49,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,108,105,103,110,45,105,116,101,109,115,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,106,117,115,116,105,102,121,45,99,111,110,116,101,110,116,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,105,110,112,117,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,54,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,98,117,116,116,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,108,101,102,116,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,117,114,115,111,114,58,32,112,111,105,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,116,121,112,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,108,101,102,116,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,117,114,115,111,114,58,32,112,111,105,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,115,101,97,114,99,104,45,110,117,109,98,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,48,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,108,101,102,116,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,53,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,99,99,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,117,114,115,111,114,58,32,112,111,105,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,99,111,110,116,101,110,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,116,111,112,58,32,49,48,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,108,101,120,58,32,57,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,111,118,101,114,102,108,111,119,45,121,58,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,60,47,115,116,121,108,101,62,13,10,32,32,32,32,60,115,116,121,108,101,62,13,10,32,32,32,32,32,32,32,32,104,116,109,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,111,118,101,114,102,108,111,119,45,121,58,32,115,99,114,111,108,108,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,45,119,101,98,107,105,116,45,116,101,120,116,45,115,105,122,101,45,97,100,106,117,115,116,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,45,109,115,45,116,101,120,116,45,115,105,122,101,45,97,100,106,117,115,116,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,13,10,32,32,32,32,32,32,32,32,112,114,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,48,54,48,52,48,52,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,49,48,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,114,97,100,105,117,115,58,32,49,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,45,99,111,108,111,114,58,32,35,70,53,70,53,70,53,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,52,52,52,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,102,97,109,105,108,121,58,32,71,101,111,114,103,105,97,44,32,80,97,108,97,116,105,110,111,44,32,226,128,152,80,97,108,97,116,105,110,111,32,76,105,110,111,116,121,112,101,226,128,152,44,32,84,105,109,101,115,44,32,226,128,152,84,105,109,101,115,32,78,101,119,32,82,111,109,97,110,226,128,152,44,32,115,101,114,105,102,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,50,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,105,110,101,45,104,101,105,103,104,116,58,32,49,46,55,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,52,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,35,102,101,102,101,102,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,52,53,97,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,100,101,99,111,114,97,116,105,111,110,58,32,110,111,110,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,118,105,115,105,116,101,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,98,48,48,56,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,104,111,118,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,97,99,116,105,118,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,102,97,97,55,48,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,102,111,99,117,115,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,111,117,116,108,105,110,101,58,32,116,104,105,110,32,100,111,116,116,101,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,42,58,58,45,109,111,122,45,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,42,58,58,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,58,45,109,111,122,45,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,52,53,97,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,97,58,58,115,101,108,101,99,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,114,103,98,97,40,50,53,53,44,32,50,53,53,44,32,48,44,32,48,46,51,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,54,52,53,97,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,49,44,13,10,32,32,32,32,32,32,32,32,104,50,44,13,10,32,32,32,32,32,32,32,32,104,51,44,13,10,32,32,32,32,32,32,32,32,104,52,44,13,10,32,32,32,32,32,32,32,32,104,53,44,13,10,32,32,32,32,32,32,32,32,104,54,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,49,49,49,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,105,110,101,45,104,101,105,103,104,116,58,32,49,50,53,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,116,111,112,58,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,110,111,114,109,97,108,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,52,44,13,10,32,32,32,32,32,32,32,32,104,53,44,13,10,32,32,32,32,32,32,32,32,104,54,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,49,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,50,46,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,50,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,51,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,46,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,52,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,46,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,53,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,54,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,48,46,57,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,98,108,111,99,107,113,117,111,116,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,54,54,54,54,54,54,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,45,108,101,102,116,58,32,51,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,108,101,102,116,58,32,48,46,53,101,109,32,35,69,69,69,32,115,111,108,105,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,104,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,98,108,111,99,107,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,50,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,116,111,112,58,32,49,112,120,32,115,111,108,105,100,32,35,97,97,97,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,35,101,101,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,112,114,101,44,13,10,32,32,32,32,32,32,32,32,99,111,100,101,44,13,10,32,32,32,32,32,32,32,32,107,98,100,44,13,10,32,32,32,32,32,32,32,32,115,97,109,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,102,97,109,105,108,121,58,32,109,111,110,111,115,112,97,99,101,44,32,109,111,110,111,115,112,97,99,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,95,102,111,110,116,45,102,97,109,105,108,121,58,32,226,128,152,99,111,117,114,105,101,114,32,110,101,119,226,128,152,44,32,109,111,110,111,115,112,97,99,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,48,46,57,56,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,112,114,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,104,105,116,101,45,115,112,97,99,101,58,32,112,114,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,104,105,116,101,45,115,112,97,99,101,58,32,112,114,101,45,119,114,97,112,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,111,114,100,45,119,114,97,112,58,32,98,114,101,97,107,45,119,111,114,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,98,44,13,10,32,32,32,32,32,32,32,32,115,116,114,111,110,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,102,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,116,121,108,101,58,32,105,116,97,108,105,99,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,105,110,115,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,35,102,102,57,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,100,101,99,111,114,97,116,105,111,110,58,32,110,111,110,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,109,97,114,107,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,35,102,102,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,35,48,48,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,116,121,108,101,58,32,105,116,97,108,105,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,115,117,98,44,13,10,32,32,32,32,32,32,32,32,115,117,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,55,53,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,108,105,110,101,45,104,101,105,103,104,116,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,111,115,105,116,105,111,110,58,32,114,101,108,97,116,105,118,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,118,101,114,116,105,99,97,108,45,97,108,105,103,110,58,32,98,97,115,101,108,105,110,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,115,117,112,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,111,112,58,32,45,48,46,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,115,117,98,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,116,116,111,109,58,32,45,48,46,50,53,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,117,108,44,13,10,32,32,32,32,32,32,32,32,111,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,48,32,48,32,48,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,108,105,32,112,58,108,97,115,116,45,99,104,105,108,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,117,108,32,117,108,44,13,10,32,32,32,32,32,32,32,32,111,108,32,111,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,46,51,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,108,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,119,101,105,103,104,116,58,32,98,111,108,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,46,56,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,32,48,32,46,56,101,109,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,100,100,58,108,97,115,116,45,99,104,105,108,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,45,109,115,45,105,110,116,101,114,112,111,108,97,116,105,111,110,45,109,111,100,101,58,32,98,105,99,117,98,105,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,118,101,114,116,105,99,97,108,45,97,108,105,103,110,58,32,109,105,100,100,108,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,102,105,103,117,114,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,105,115,112,108,97,121,58,32,98,108,111,99,107,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,97,108,105,103,110,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,101,109,32,48,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,102,105,103,117,114,101,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,110,111,110,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,32,97,117,116,111,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,102,105,103,99,97,112,116,105,111,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,48,46,56,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,116,121,108,101,58,32,105,116,97,108,105,99,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,48,32,48,32,46,56,101,109,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,116,97,98,108,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,45,98,111,116,116,111,109,58,32,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,114,105,103,104,116,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,115,112,97,99,105,110,103,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,99,111,108,108,97,112,115,101,58,32,99,111,108,108,97,112,115,101,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,116,97,98,108,101,32,116,104,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,46,50,101,109,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,45,99,111,108,111,114,58,32,35,101,101,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,116,111,112,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,108,101,102,116,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,116,97,98,108,101,32,116,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,58,32,46,50,101,109,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,116,111,112,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,108,101,102,116,58,32,49,112,120,32,115,111,108,105,100,32,35,100,100,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,118,101,114,116,105,99,97,108,45,97,108,105,103,110,58,32,116,111,112,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,46,97,117,116,104,111,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,46,50,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,97,108,105,103,110,58,32,99,101,110,116,101,114,59,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,64,109,101,100,105,97,32,111,110,108,121,32,115,99,114,101,101,110,32,97,110,100,32,40,109,105,110,45,119,105,100,116,104,58,32,52,56,48,112,120,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,52,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,64,109,101,100,105,97,32,111,110,108,121,32,115,99,114,101,101,110,32,97,110,100,32,40,109,105,110,45,119,105,100,116,104,58,32,55,54,56,112,120,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,54,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,64,109,101,100,105,97,32,112,114,105,110,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,42,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,97,99,107,103,114,111,117,110,100,58,32,116,114,97,110,115,112,97,114,101,110,116,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,108,111,114,58,32,98,108,97,99,107,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,105,108,116,101,114,58,32,110,111,110,101,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,45,109,115,45,102,105,108,116,101,114,58,32,110,111,110,101,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,111,100,121,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,110,116,45,115,105,122,101,58,32,49,50,112,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,49,48,48,37,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,58,118,105,115,105,116,101,100,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,101,120,116,45,100,101,99,111,114,97,116,105,111,110,58,32,117,110,100,101,114,108,105,110,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,104,101,105,103,104,116,58,32,49,112,120,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,48,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,45,98,111,116,116,111,109,58,32,49,112,120,32,115,111,108,105,100,32,98,108,97,99,107,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,91,104,114,101,102,93,58,97,102,116,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,34,32,40,34,32,97,116,116,114,40,104,114,101,102,41,32,34,41,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,98,98,114,91,116,105,116,108,101,93,58,97,102,116,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,34,32,40,34,32,97,116,116,114,40,116,105,116,108,101,41,32,34,41,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,46,105,114,32,97,58,97,102,116,101,114,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,91,104,114,101,102,94,61,34,106,97,118,97,115,99,114,105,112,116,58,34,93,58,97,102,116,101,114,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,97,91,104,114,101,102,94,61,34,35,34,93,58,97,102,116,101,114,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,34,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,114,101,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,108,111,99,107,113,117,111,116,101,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,98,111,114,100,101,114,58,32,49,112,120,32,115,111,108,105,100,32,35,57,57,57,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,100,100,105,110,103,45,114,105,103,104,116,58,32,49,101,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,103,101,45,98,114,101,97,107,45,105,110,115,105,100,101,58,32,97,118,111,105,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,116,114,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,103,101,45,98,114,101,97,107,45,105,110,115,105,100,101,58,32,97,118,111,105,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,105,109,103,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,45,119,105,100,116,104,58,32,49,48,48,37,32,33,105,109,112,111,114,116,97,110,116,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,64,112,97,103,101,32,58,108,101,102,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,53,109,109,32,50,48,109,109,32,49,53,109,109,32,49,48,109,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,64,112,97,103,101,32,58,114,105,103,104,116,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,114,103,105,110,58,32,49,53,109,109,32,49,48,109,109,32,49,53,109,109,32,50,48,109,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,112,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,50,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,51,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,111,114,112,104,97,110,115,58,32,51,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,119,105,100,111,119,115,58,32,51,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,50,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,104,51,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,97,103,101,45,98,114,101,97,107,45,97,102,116,101,114,58,32,97,118,111,105,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,13,10,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,60,47,115,116,121,108,101,62,13,10,60,47,104,101,97,100,62,13,10,13,10,60,98,111,100,121,62,13,10,32,32,32,32,60,100,105,118,32,105,100,61,34,97,112,112,34,62,13,10,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,99,111,110,116,97,105,110,101,114,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,98,97,114,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,105,110,112,117,116,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,105,110,112,117,116,34,32,118,45,109,111,100,101,108,61,34,107,101,121,119,111,114,100,34,32,116,121,112,101,61,34,116,101,120,116,34,32,112,108,97,99,101,104,111,108,100,101,114,61,34,232,175,183,232,190,147,229,133,165,229,133,179,233,148,174,232,175,141,34,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,64,107,101,121,100,111,119,110,46,101,110,116,101,114,61,34,112,101,114,102,111,114,109,83,101,97,114,99,104,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,98,117,116,116,111,110,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,98,117,116,116,111,110,34,32,64,99,108,105,99,107,61,34,112,101,114,102,111,114,109,83,101,97,114,99,104,34,62,83,101,97,114,99,104,60,47,98,117,116,116,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,115,101,108,101,99,116,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,116,121,112,101,34,32,118,45,109,111,100,101,108,61,34,115,101,97,114,99,104,84,121,112,101,34,32,64,99,104,97,110,103,101,61,34,115,97,118,101,80,114,101,102,101,114,101,110,99,101,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,33,45,45,32,228,189,191,231,148,168,118,45,102,111,114,230,140,135,228,187,164,229,174,158,231,142,176,32,45,45,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,111,112,116,105,111,110,32,118,45,102,111,114,61,34,115,101,97,114,99,104,101,114,32,105,110,32,115,101,97,114,99,104,101,114,115,34,32,58,107,101,121,61,34,115,101,97,114,99,104,101,114,46,116,121,112,101,34,32,58,118,97,108,117,101,61,34,115,101,97,114,99,104,101,114,46,116,121,112,101,34,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,58,100,105,115,97,98,108,101,100,61,34,115,101,97,114,99,104,101,114,46,104,101,97,108,116,104,121,32,61,61,61,32,102,97,108,115,101,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,123,123,32,115,101,97,114,99,104,101,114,46,98,114,105,101,102,32,125,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,111,112,116,105,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,33,45,45,32,60,111,112,116,105,111,110,32,118,97,108,117,101,61,34,107,101,121,119,111,114,100,34,62,229,133,179,233,148,174,232,175,141,230,144,156,231,180,162,60,47,111,112,116,105,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,60,111,112,116,105,111,110,32,118,97,108,117,101,61,34,116,105,116,108,101,34,32,62,229,159,186,228,186,142,230,160,135,233,162,152,231,154,132,230,150,135,230,156,172,232,183,157,231,166,187,230,144,156,231,180,162,60,47,111,112,116,105,111,110,62,13,10,32,32,32,32,32,32,32,32,32,32,60,111,112,116,105,111,110,32,118,97,108,117,101,61,34,99,111,110,116,101,110,116,34,62,230,150,135,231,171,160,229,134,133,229,174,185,229,140,185,233,133,141,230,144,156,231,180,162,60,47,111,112,116,105,111,110,62,32,45,45,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,115,101,108,101,99,116,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,105,110,112,117,116,32,99,108,97,115,115,61,34,115,101,97,114,99,104,45,110,117,109,98,101,114,34,32,116,121,112,101,61,34,110,117,109,98,101,114,34,32,118,45,109,111,100,101,108,61,34,115,101,97,114,99,104,78,117,109,34,32,105,100,61,34,113,117,97,110,116,105,116,121,34,32,110,97,109,101,61,34,113,117,97,110,116,105,116,121,34,32,109,105,110,61,34,49,34,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,109,97,120,61,34,49,48,48,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,99,111,110,116,101,110,116,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,118,45,105,102,61,34,105,115,76,111,97,100,105,110,103,34,62,76,111,97,100,105,110,103,46,46,46,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,118,45,105,102,61,34,105,115,66,97,115,101,34,62,32,36,116,111,99,36,32,60,98,114,62,60,98,114,62,32,36,98,111,100,121,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,105,102,40,117,112,100,97,116,101,100,41,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,112,32,99,108,97,115,115,61,34,101,98,45,103,105,116,45,109,101,116,97,34,62,67,114,101,97,116,101,100,32,36,99,114,101,97,116,101,100,36,32,38,109,105,100,100,111,116,59,32,85,112,100,97,116,101,100,32,36,117,112,100,97,116,101,100,36,36,105,102,40,97,117,116,104,111,114,115,41,36,32,38,109,105,100,100,111,116,59,32,98,121,32,36,102,111,114,40,97,117,116,104,111,114,115,41,36,36,97,117,116,104,111,114,115,36,36,115,101,112,36,44,32,36,101,110,100,102,111,114,36,36,101,110,100,105,102,36,60,47,112,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,101,110,100,105,102,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,105,102,40,98,97,99,107,108,105,110,107,115,41,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,101,98,45,98,97,99,107,108,105,110,107,115,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,104,51,62,76,105,110,107,101,100,32,102,114,111,109,60,47,104,51,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,117,108,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,102,111,114,40,98,97,99,107,108,105,110,107,115,41,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,108,105,62,60,97,32,104,114,101,102,61,34,36,98,97,99,107,108,105,110,107,115,46,117,114,108,36,34,62,36,98,97,99,107,108,105,110,107,115,46,116,105,116,108,101,36,60,47,97,62,60,47,108,105,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,101,110,100,102,111,114,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,117,108,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,101,110,100,105,102,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,105,102,40,114,101,108,97,116,101,100,41,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,99,108,97,115,115,61,34,101,98,45,114,101,108,97,116,101,100,34,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,104,51,62,82,101,108,97,116,101,100,32,112,111,115,116,115,60,47,104,51,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,117,108,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,102,111,114,40,114,101,108,97,116,101,100,41,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,108,105,62,60,97,32,104,114,101,102,61,34,36,114,101,108,97,116,101,100,46,117,114,108,36,34,62,36,114,101,108,97,116,101,100,46,116,105,116,108,101,36,60,47,97,62,60,47,108,105,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,101,110,100,102,111,114,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,117,108,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,36,101,110,100,105,102,36,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,60,100,105,118,32,118,45,101,108,115,101,32,118,45,104,116,109,108,61,34,99,111,110,116,101,110,116,34,62,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,32,32,32,32,60,47,100,105,118,62,13,10,32,32,32,32,60,47,100,105,118,62,13,10,13,10,32,32,32,32,60,115,99,114,105,112,116,62,13,10,32,32,32,32,32,32,32,32,99,111,110,115,116,32,97,112,112,32,61,32,86,117,101,46,99,114,101,97,116,101,65,112,112,40,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,100,97,116,97,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,114,101,116,117,114,110,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,107,101,121,119,111,114,100,58,32,39,39,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,105,115,76,111,97,100,105,110,103,58,32,102,97,108,115,101,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,105,115,66,97,115,101,58,32,116,114,117,101,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,116,101,110,116,58,32,39,39,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,78,117,109,58,32,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,78,117,109,39,41,32,124,124,32,49,48,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,84,121,112,101,58,32,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,84,121,112,101,39,41,32,124,124,32,39,116,105,116,108,101,39,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,101,114,115,58,32,74,83,79,78,46,112,97,114,115,101,40,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,101,114,115,39,41,41,32,124,124,32,91,93,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,99,114,101,97,116,101,100,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,105,102,32,40,33,108,111,99,97,108,83,116,111,114,97,103,101,46,103,101,116,73,116,101,109,40,39,115,101,97,114,99,104,101,114,115,39,41,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,102,101,116,99,104,83,101,97,114,99,104,101,114,115,40,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,115,101,97,114,99,104,101,114,115,82,101,102,114,101,115,104,73,110,116,101,114,118,97,108,73,100,32,61,32,115,101,116,73,110,116,101,114,118,97,108,40,116,104,105,115,46,102,101,116,99,104,83,101,97,114,99,104,101,114,115,44,32,49,48,48,48,48,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,98,101,102,111,114,101,85,110,109,111,117,110,116,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,108,101,97,114,73,110,116,101,114,118,97,108,40,116,104,105,115,46,115,101,97,114,99,104,101,114,115,82,101,102,114,101,115,104,73,110,116,101,114,118,97,108,73,100,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,109,101,116,104,111,100,115,58,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,101,116,99,104,83,101,97,114,99,104,101,114,115,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,114,101,116,117,114,110,32,102,101,116,99,104,40,34,47,97,112,105,47,115,101,97,114,99,104,101,114,115,34,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,114,101,115,112,111,110,115,101,32,61,62,32,114,101,115,112,111,110,115,101,46,106,115,111,110,40,41,41,32,32,47,47,32,233,166,150,229,133,136,232,167,163,230,158,144,74,83,79,78,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,100,97,116,97,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,230,149,176,230,141,174,232,167,163,230,158,144,230,136,144,229,138,159,229,144,142,239,188,140,229,176,134,229,133,182,229,173,152,229,130,168,229,136,176,108,111,99,97,108,83,116,111,114,97,103,101,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,111,99,97,108,83,116,111,114,97,103,101,46,115,101,116,73,116,101,109,40,39,115,101,97,114,99,104,101,114,115,39,44,32,74,83,79,78,46,115,116,114,105,110,103,105,102,121,40,100,97,116,97,41,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,231,132,182,229,144,142,229,176,134,230,149,176,230,141,174,232,181,139,229,128,188,231,187,153,116,104,105,115,46,115,101,97,114,99,104,101,114,115,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,115,101,97,114,99,104,101,114,115,32,61,32,100,97,116,97,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,99,97,116,99,104,40,101,114,114,111,114,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,111,108,101,46,101,114,114,111,114,40,39,230,144,156,231,180,162,232,175,183,230,177,130,229,164,177,232,180,165,58,39,44,32,101,114,114,111,114,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,112,101,114,102,111,114,109,83,101,97,114,99,104,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,76,111,97,100,105,110,103,32,61,32,116,114,117,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,66,97,115,101,32,61,32,102,97,108,115,101,59,13,10,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,230,158,132,233,128,160,230,144,156,231,180,162,232,175,183,230,177,130,231,154,132,32,85,82,76,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,116,32,107,101,121,119,111,114,100,32,61,32,116,104,105,115,46,107,101,121,119,111,114,100,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,116,32,117,114,108,32,61,32,34,47,97,112,105,47,115,101,97,114,99,104,63,107,101,121,119,111,114,100,61,34,32,43,32,101,110,99,111,100,101,85,82,73,67,111,109,112,111,110,101,110,116,40,107,101,121,119,111,114,100,41,32,43,32,34,38,115,101,97,114,99,104,84,121,112,101,61,34,32,43,32,116,104,105,115,46,115,101,97,114,99,104,84,121,112,101,32,43,32,34,38,110,117,109,61,34,32,43,32,116,104,105,115,46,115,101,97,114,99,104,78,117,109,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,111,108,101,46,108,111,103,40,117,114,108,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,229,138,160,229,133,165,230,144,156,231,180,162,231,177,187,229,158,139,229,143,130,230,149,176,32,115,101,97,114,99,104,116,121,112,101,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,229,143,145,233,128,129,231,189,145,231,187,156,232,175,183,230,177,130,232,142,183,229,143,150,233,147,190,230,142,165,230,149,176,231,187,132,231,154,132,32,74,83,79,78,32,229,147,141,229,186,148,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,80,114,111,109,105,115,101,46,114,97,99,101,40,91,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,101,116,99,104,40,117,114,108,41,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,110,101,119,32,80,114,111,109,105,115,101,40,40,114,101,115,111,108,118,101,44,32,114,101,106,101,99,116,41,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,116,84,105,109,101,111,117,116,40,40,41,32,61,62,32,114,101,106,101,99,116,40,110,101,119,32,69,114,114,111,114,40,39,232,175,183,230,177,130,232,182,133,230,151,182,39,41,41,44,32,53,48,48,48,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,93,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,114,101,115,112,111,110,115,101,32,61,62,32,114,101,115,112,111,110,115,101,46,106,115,111,110,40,41,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,116,104,101,110,40,100,97,116,97,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,99,111,110,116,101,110,116,32,61,32,116,104,105,115,46,103,101,110,101,114,97,116,101,76,105,110,107,76,105,115,116,40,100,97,116,97,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,76,111,97,100,105,110,103,32,61,32,102,97,108,115,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,46,99,97,116,99,104,40,101,114,114,111,114,32,61,62,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,111,108,101,46,101,114,114,111,114,40,39,230,144,156,231,180,162,232,175,183,230,177,130,229,164,177,232,180,165,58,39,44,32,101,114,114,111,114,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,116,104,105,115,46,105,115,76,111,97,100,105,110,103,32,61,32,102,97,108,115,101,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,103,101,110,101,114,97,116,101,76,105,110,107,76,105,115,116,40,108,105,110,107,65,114,114,97,121,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,101,116,32,104,116,109,108,32,61,32,39,60,117,108,62,39,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,102,111,114,32,40,108,101,116,32,105,32,61,32,48,59,32,105,32,60,32,108,105,110,107,65,114,114,97,121,46,108,101,110,103,116,104,59,32,105,43,43,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,99,111,110,115,116,32,117,114,108,32,61,32,108,105,110,107,65,114,114,97,121,91,105,93,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,104,116,109,108,32,43,61,32,34,60,108,105,62,60,97,32,104,114,101,102,61,92,34,34,32,43,32,117,114,108,32,43,32,34,92,34,62,34,32,43,32,117,114,108,32,43,32,34,60,47,97,62,60,47,108,105,62,34,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,104,116,109,108,32,43,61,32,39,60,47,117,108,62,39,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,114,101,116,117,114,110,32,104,116,109,108,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,97,118,101,80,114,101,102,101,114,101,110,99,101,40,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,111,99,97,108,83,116,111,114,97,103,101,46,115,101,116,73,116,101,109,40,39,115,101,97,114,99,104,84,121,112,101,39,44,32,116,104,105,115,46,115,101,97,114,99,104,84,121,112,101,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,44,13,10,32,32,32,32,32,32,32,32,32,32,32,32,119,97,116,99,104,58,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,47,47,32,230,175,143,229,189,147,115,101,97,114,99,104,78,117,109,230,148,185,229,143,152,230,151,182,239,188,140,233,131,189,229,176,134,229,133,182,228,191,157,229,173,152,229,136,176,108,111,99,97,108,83,116,111,114,97,103,101,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,115,101,97,114,99,104,78,117,109,40,110,101,119,86,97,108,41,32,123,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,108,111,99,97,108,83,116,111,114,97,103,101,46,115,101,116,73,116,101,109,40,39,115,101,97,114,99,104,78,117,109,39,44,32,110,101,119,86,97,108,41,59,13,10,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,32,32,32,32,125,13,10,32,32,32,32,32,32,32,32,125,41,59,13,10,13,10,32,32,32,32,32,32,32,32,97,112,112,46,109,111,117,110,116,40,39,35,97,112,112,39,41,59,13,10,32,32,32,32,60,47,115,99,114,105,112,116,62,13,10,60,47,98,111,100,121,62,13,10,13,10,60,47,104,116,109,108,62,}
 var DEFAULT_BLOG = []byte{35,32,87,101,108,99,111,109,101,32,116,111,32,69,97,115,121,32,66,108,111,103,13,10,13,10,35,35,32,85,115,97,103,101,13,10,13,10,101,110,116,101,114,32,96,101,98,32,45,104,96,44,119,104,105,99,104,32,115,104,111,119,115,32,101,118,101,114,121,116,104,105,110,103,32,121,111,117,32,119,97,110,116,32,13,10,13,10,35,35,32,67,111,110,116,101,110,116,13,10,13,10,91,97,32,108,105,110,107,32,116,111,32,97,32,104,105,100,101,32,98,108,111,103,93,40,46,47,104,105,100,101,46,109,100,41,44,121,111,117,32,99,97,110,39,116,32,102,105,110,100,32,105,116,32,119,104,105,108,101,32,115,101,97,114,99,104,105,110,103,32,111,114,32,119,97,108,107,105,110,103,32,116,104,114,111,117,103,104,32,100,105,114,115,44,98,117,116,32,116,104,105,115,32,98,108,111,103,32,105,115,32,97,99,116,117,97,108,108,121,32,112,114,101,115,101,110,116,46,13,10,13,10,91,97,32,108,105,110,107,32,116,111,32,97,32,112,114,105,118,97,116,101,32,98,108,111,103,93,40,46,47,112,114,105,118,97,116,101,46,109,100,41,44,13,10,121,111,117,32,119,105,108,108,32,110,101,105,116,104,101,114,32,102,105,110,100,32,105,116,32,119,104,105,108,101,32,115,101,97,114,99,104,105,110,103,32,111,114,32,119,97,108,107,105,110,103,32,116,104,114,111,117,103,104,32,100,105,114,115,44,97,110,100,32,97,108,115,111,32,110,111,116,32,97,98,108,101,32,116,111,32,103,101,116,32,116,111,32,105,116,46,73,110,32,102,97,99,116,44,105,102,32,121,111,117,32,99,108,105,99,107,32,116,104,105,115,32,108,105,110,107,44,121,111,117,32,119,105,108,108,32,103,101,116,13,10,97,32,52,48,52,46,}
 var DEFAULT_HIDE = []byte{35,32,72,105,100,101,32,66,108,111,103,47,70,105,108,101,115,13,10,13,10,60,33,45,45,32,32,45,45,62,13,10,121,111,117,32,99,97,110,32,117,115,101,32,115,117,99,104,32,98,108,111,103,47,102,105,108,101,32,116,111,32,112,108,97,99,101,32,115,111,109,101,32,114,101,115,111,117,114,99,101,115,32,116,104,97,116,13,10,121,111,117,32,100,111,32,110,111,116,32,119,97,110,116,32,105,116,32,116,111,32,112,114,101,115,101,110,116,32,105,110,32,100,105,114,115,32,111,114,32,115,101,97,114,99,104,32,114,101,115,117,108,116,115,44,13,10,98,117,116,32,115,116,105,108,108,32,119,97,110,116,32,116,111,32,104,97,118,101,32,97,32,97,99,99,101,115,115,32,116,111,46,}
 var DEFAULT_PRIVATE = []byte{35,32,84,104,105,115,32,105,115,32,121,111,117,114,32,80,114,105,118,97,116,101,32,66,108,111,103,13,10,13,10,121,111,117,32,99,97,110,32,119,114,105,116,101,32,121,111,117,114,32,112,114,105,118,97,116,101,32,105,100,101,97,32,104,101,114,101,}
-var DEFAULT_HELP = []byte{101,98,58,32,101,97,115,121,32,98,108,111,103,13,10,13,10,100,101,112,101,110,100,101,110,99,105,101,115,58,13,10,49,46,32,105,110,115,116,97,108,108,32,112,97,110,100,111,99,32,97,110,100,32,97,100,100,32,105,116,32,105,110,116,111,32,101,110,118,105,114,111,110,109,101,110,116,115,13,10,96,96,96,13,10,35,32,111,110,32,119,105,110,100,111,119,115,13,10,115,99,111,111,112,32,105,110,115,116,97,108,108,32,112,97,110,100,111,99,13,10,35,32,111,110,32,85,98,117,110,116,117,13,10,115,117,100,111,32,97,112,116,32,117,112,100,97,116,101,32,38,38,32,115,117,100,111,32,97,112,116,32,105,110,115,116,97,108,108,32,112,97,110,100,111,99,13,10,96,96,96,13,10,13,10,102,108,97,103,115,58,13,10,45,104,58,32,112,114,105,110,116,32,104,101,108,112,32,109,101,115,115,97,103,101,13,10,45,115,58,32,115,116,97,114,116,32,115,101,114,118,101,114,13,10,45,110,58,32,99,114,101,97,116,101,32,97,32,110,101,119,32,98,108,111,103,32,115,116,114,117,99,116,117,114,101,32,105,110,32,99,117,114,114,101,110,116,32,100,105,114,101,99,116,111,114,121,13,10,45,118,58,32,112,114,105,110,116,32,118,101,114,115,105,111,110,13,10,13,10,85,115,97,103,101,58,13,10,101,98,32,45,104,13,10,101,98,32,45,115,13,10,101,98,32,45,110,13,10,101,98,32,45,118,13,10,13,10,113,117,105,99,107,32,115,116,97,114,116,58,13,10,96,96,96,115,104,13,10,101,98,32,45,110,13,10,101,98,32,45,115,13,10,96,96,96,13,10,116,104,101,110,32,118,105,115,105,116,32,104,116,116,112,58,47,47,108,111,99,97,108,104,111,115,116,58,56,48,56,48,47,98,108,111,103,47,}
+var DEFAULT_HELP = []byte{101,98,58,32,101,97,115,121,32,98,108,111,103,13,10,13,10,100,101,112,101,110,100,101,110,99,105,101,115,58,13,10,49,46,32,105,110,115,116,97,108,108,32,112,97,110,100,111,99,32,97,110,100,32,97,100,100,32,105,116,32,105,110,116,111,32,101,110,118,105,114,111,110,109,101,110,116,115,13,10,96,96,96,13,10,35,32,111,110,32,119,105,110,100,111,119,115,13,10,115,99,111,111,112,32,105,110,115,116,97,108,108,32,112,97,110,100,111,99,13,10,35,32,111,110,32,85,98,117,110,116,117,13,10,115,117,100,111,32,97,112,116,32,117,112,100,97,116,101,32,38,38,32,115,117,100,111,32,97,112,116,32,105,110,115,116,97,108,108,32,112,97,110,100,111,99,13,10,96,96,96,13,10,13,10,102,108,97,103,115,58,13,10,45,104,58,32,112,114,105,110,116,32,104,101,108,112,32,109,101,115,115,97,103,101,13,10,45,115,58,32,115,116,97,114,116,32,115,101,114,118,101,114,13,10,45,110,58,32,99,114,101,97,116,101,32,97,32,110,101,119,32,98,108,111,103,32,115,116,114,117,99,116,117,114,101,32,105,110,32,99,117,114,114,101,110,116,32,100,105,114,101,99,116,111,114,121,13,10,45,118,58,32,112,114,105,110,116,32,118,101,114,115,105,111,110,13,10,115,104,97,114,101,58,32,99,114,101,97,116,101,32,97,32,115,105,103,110,101,100,32,108,105,110,107,32,102,111,114,32,97,32,112,114,105,118,97,116,101,32,98,108,111,103,13,10,32,32,32,32,101,98,32,115,104,97,114,101,32,91,45,101,32,50,52,104,93,32,91,45,110,32,48,93,32,60,117,114,108,62,13,10,32,32,32,32,101,98,32,115,104,97,114,101,32,45,108,32,32,32,32,32,32,32,108,105,115,116,32,111,117,116,115,116,97,110,100,105,110,103,32,108,105,110,107,115,13,10,32,32,32,32,101,98,32,115,104,97,114,101,32,45,114,111,116,97,116,101,32,32,114,111,116,97,116,101,32,116,104,101,32,107,101,121,32,97,110,100,32,114,101,118,111,107,101,32,97,108,108,32,108,105,110,107,115,13,10,99,104,101,99,107,32,108,105,110,107,115,58,32,114,101,112,111,114,116,32,98,114,111,107,101,110,32,108,105,110,107,115,44,32,109,105,115,115,105,110,103,32,97,110,99,104,111,114,115,44,32,108,105,110,107,115,32,116,111,32,112,114,105,118,97,116,101,32,112,97,103,101,115,32,97,110,100,32,111,114,112,104,97,110,115,13,10,32,32,32,32,101,98,32,99,104,101,99,107,32,108,105,110,107,115,32,91,45,101,120,116,101,114,110,97,108,93,32,91,45,106,115,111,110,93,13,10,98,117,110,100,108,101,58,32,98,117,105,108,100,32,97,32,115,105,110,103,108,101,32,101,120,101,99,117,116,97,98,108,101,32,115,101,114,118,105,110,103,32,116,104,101,32,98,108,111,103,44,32,119,105,116,104,111,117,116,32,112,97,110,100,111,99,32,111,114,32,102,105,108,101,115,32,111,110,32,100,105,115,107,13,10,32,32,32,32,101,98,32,98,117,110,100,108,101,32,91,45,111,32,101,98,45,98,117,110,100,108,101,93,13,10,32,32,32,32,46,47,101,98,45,98,117,110,100,108,101,32,91,45,112,32,56,48,56,48,93,13,10,13,10,85,115,97,103,101,58,13,10,101,98,32,45,104,13,10,101,98,32,45,115,13,10,101,98,32,45,110,13,10,101,98,32,45,118,13,10,101,98,32,115,104,97,114,101,32,47,98,108,111,103,47,112,114,105,118,97,116,101,46,109,100,13,10,101,98,32,99,104,101,99,107,32,108,105,110,107,115,13,10,101,98,32,98,117,110,100,108,101,32,45,111,32,109,121,98,108,111,103,13,10,13,10,113,117,105,99,107,32,115,116,97,114,116,58,13,10,96,96,96,115,104,13,10,101,98,32,45,110,13,10,101,98,32,45,115,13,10,96,96,96,13,10,116,104,101,110,32,118,105,115,105,116,32,104,116,116,112,58,47,47,108,111,99,97,108,104,111,115,116,58,56,48,56,48,47,98,108,111,103,47,}
 var DEFAULT_VERSION = []byte{118,101,114,115,105,111,110,32,48,46,48,46,48,}
 var DEFAULT_KEYWORD = []byte{45,45,45,13,10,107,101,121,119,111,114,100,115,58,32,91,34,82,117,115,116,34,44,32,34,77,100,34,44,32,34,71,111,34,93,13,10,45,45,45,}
 var DEFAULT_FAVICON = []byte{0,0,1,0,3,0,16,16,0,0,0,0,32,0,18,1,0,0,54,0,0,0,24,24,0,0,0,0,32,0,76,1,0,0,72,1,0,0,32,32,0,0,0,0,32,0,175,0,0,0,148,2,0,0,137,80,78,71,13,10,26,10,0,0,0,13,73,72,68,82,0,0,0,16,0,0,0,16,8,6,0,0,0,31,243,255,97,0,0,0,217,73,68,65,84,120,156,205,146,59,142,132,48,16,68,171,173,13,64,66,144,112,5,46,64,66,66,74,76,128,68,196,9,185,2,18,17,17,119,224,2,220,192,106,211,61,193,104,62,11,222,217,93,49,193,84,104,171,95,85,181,77,170,170,56,33,115,102,248,51,0,95,190,67,17,129,136,60,92,140,129,49,126,47,58,187,196,111,9,68,4,198,24,204,243,140,113,28,17,134,33,156,115,40,203,18,69,81,220,239,127,5,12,195,0,34,66,219,182,112,206,33,73,146,107,92,162,215,9,110,138,162,8,170,138,91,187,32,8,254,7,32,34,76,211,4,102,198,182,109,104,154,6,89,150,65,85,15,16,47,192,90,139,174,235,80,215,245,1,252,231,10,125,223,99,89,22,48,51,242,60,71,85,85,222,4,222,103,100,102,172,235,10,107,45,0,32,142,99,164,105,234,243,122,243,63,120,214,158,235,235,255,18,240,211,192,94,23,244,132,94,82,110,22,15,244,0,0,0,0,73,69,78,68,174,66,96,130,137,80,78,71,13,10,26,10,0,0,0,13,73,72,68,82,0,0,0,24,0,0,0,24,8,6,0,0,0,224,119,61,248,0,0,1,19,73,68,65,84,120,156,237,84,59,170,132,64,16,172,81,17,196,15,98,42,120,6,3,115,65,19,115,99,79,96,182,32,120,34,83,143,225,33,4,99,15,160,129,136,221,27,173,176,176,58,239,33,6,203,123,21,22,61,83,211,83,213,45,152,153,113,35,148,59,47,255,23,248,35,2,154,172,128,136,240,41,201,138,162,64,8,33,21,16,119,207,193,97,7,204,12,33,4,250,190,199,48,12,152,166,105,231,152,25,73,146,192,243,188,157,251,181,192,182,109,208,52,13,85,85,161,239,123,164,105,10,34,130,170,170,32,34,68,81,116,77,224,117,200,178,44,20,69,129,186,174,63,214,41,202,121,78,164,41,34,34,16,145,172,236,16,167,30,0,128,170,170,104,219,22,227,56,238,188,239,251,40,203,18,182,109,95,255,34,102,70,16,4,136,227,24,68,4,33,4,92,215,133,174,235,215,58,120,129,136,16,134,33,242,60,63,125,200,17,126,228,193,182,109,178,178,67,72,61,48,77,19,77,211,160,235,186,183,152,62,30,15,100,89,38,245,64,58,201,235,186,98,158,103,44,203,242,198,59,142,3,195,48,164,29,220,190,42,190,127,93,127,191,192,19,201,133,130,54,14,132,208,228,0,0,0,0,73,69,78,68,174,66,96,130,137,80,78,71,13,10,26,10,0,0,0,13,73,72,68,82,0,0,0,32,0,0,0,32,8,6,0,0,0,115,122,122,244,0,0,0,118,73,68,65,84,120,156,237,215,177,13,192,32,12,4,192,119,106,118,129,157,60,2,99,178,14,253,167,75,19,82,56,34,65,81,254,165,111,241,73,184,177,145,36,22,102,91,57,92,0,1,4,16,64,128,239,1,74,41,48,179,97,83,74,207,3,0,192,221,65,242,212,222,251,59,128,217,17,0,12,36,231,76,0,195,214,90,35,79,29,9,3,220,253,214,160,171,44,255,2,1,166,45,33,0,182,214,194,59,96,164,238,2,1,4,248,57,96,7,123,180,104,63,153,65,201,133,0,0,0,0,73,69,78,68,174,66,96,130,}
@@ -3,6 +3,7 @@ module github.com/easy-projects/easyblog
 go 1.21.6
 
 require (
+	github.com/andybalholm/brotli v1.1.1
 	github.com/cncsmonster/fspider v0.0.8
 	github.com/didip/tollbooth v4.0.2+incompatible
 	github.com/gin-contrib/cors v1.5.0
@@ -17,14 +18,15 @@ require (
 )
 
 require (
+	github.com/BurntSushi/toml v1.3.2
 	github.com/blevesearch/bleve v1.0.14
 	github.com/cncsmonster/gofsutil v0.0.1
 	github.com/google/shlex v0.0.0-20191202100458-e7afc7fbc510
+	golang.org/x/crypto v0.14.0
 	gopkg.in/yaml.v2 v2.4.0
 )
 
 require (
-	github.com/BurntSushi/toml v1.3.2 // indirect
 	github.com/PuerkitoBio/goquery v1.8.1 // indirect
 	github.com/RoaringBitmap/roaring v0.4.23 // indirect
 	github.com/andybalholm/cascadia v1.3.1 // indirect
@@ -79,7 +81,6 @@ require (
 	github.com/willf/bitset v1.1.10 // indirect
 	go.etcd.io/bbolt v1.3.5 // indirect
 	golang.org/x/arch v0.5.0 // indirect
-	golang.org/x/crypto v0.14.0 // indirect
 	golang.org/x/sys v0.13.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
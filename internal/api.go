@@ -0,0 +1,224 @@
+package internal
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"strconv"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/easy-projects/easyblog/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// ===== json api =====
+
+// keys of the tree and the archive in api cache, they never collide with a path
+const (
+	API_CACHE_TREE    = "\x00tree"
+	API_CACHE_ARCHIVE = "\x00archive"
+)
+
+// resolve ?path= to a visible file, private blogs need a valid ?share= token
+func apiPath(c *gin.Context, blogLoader *pkg.BlogLoader, shares pkg.ShareLinker) (string, bool) {
+	url := c.Query("path")
+	path, err := blogLoader.Url2Path(url)
+	if err != nil || blogLoader.PathMatch(path, blogLoader.Hide) {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": "blog not found",
+		})
+		return "", false
+	}
+	if blogLoader.PathMatch(path, blogLoader.Private) {
+		if token := c.Query("share"); token == "" || !shares.Verify(url, token) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error": "blog not found",
+			})
+			return "", false
+		}
+		c.Header("Cache-Control", "private, no-store")
+	}
+	return path, true
+}
+
+// === handle blog json ===
+func BlogJsonMiddleWare(apiCache, blogCache pkg.Cache, blogLoader *pkg.BlogLoader, shares pkg.ShareLinker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path, ok := apiPath(c, blogLoader, shares)
+		if !ok {
+			return
+		}
+		if blogJson, found := apiCache.Get(path); found {
+			c.JSON(http.StatusOK, blogJson)
+			return
+		}
+		var blog *pkg.BlogItem
+		if blogI, found := blogCache.Get(path); found {
+			blog = blogI.(*pkg.BlogItem)
+		} else {
+			var err error
+			if blog, err = blogLoader.LoadBlog(path); err != nil {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			if !blog.Streamed {
+				blogCache.Set(path, blog)
+			}
+		}
+		blogJson, err := blogLoader.BlogJson(blog)
+		if err != nil {
+			log.Println("[api] render fragment failed:", path, err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		apiCache.Set(path, blogJson)
+		c.JSON(http.StatusOK, blogJson)
+	}
+}
+
+// === handle dir json ===
+func DirJsonMiddleWare(blogLoader *pkg.BlogLoader, shares pkg.ShareLinker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path, ok := apiPath(c, blogLoader, shares)
+		if !ok {
+			return
+		}
+		if stat, err := blogLoader.Stat(path); err != nil || !stat.IsDir() {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "path is not a dir",
+			})
+			return
+		}
+		options, _, err := DirOptions(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		listing, err := blogLoader.ListDir(path, options)
+		if errors.Is(err, fs.ErrNotExist) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		} else if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusOK, struct {
+			Url string `json:"url"`
+			pkg.DirListing
+		}{blogLoader.Path2Url(path), listing})
+	}
+}
+
+// === handle tree json ===
+func TreeJsonMiddleWare(apiCache pkg.Cache, blogLoader *pkg.BlogLoader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if tree, found := apiCache.Get(API_CACHE_TREE); found {
+			c.JSON(http.StatusOK, tree)
+			return
+		}
+		tree, err := blogLoader.Tree()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		apiCache.Set(API_CACHE_TREE, tree)
+		c.JSON(http.StatusOK, tree)
+	}
+}
+
+// === handle backlinks ===
+func BacklinksMiddleWare(links pkg.LinkGraph, blogLoader *pkg.BlogLoader, shares pkg.ShareLinker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path, ok := apiPath(c, blogLoader, shares)
+		if !ok {
+			return
+		}
+		c.JSON(http.StatusOK, links.Backlinks(path))
+	}
+}
+
+// === handle related posts ===
+// related is nil when related posts are disabled
+func RelatedMiddleWare(related pkg.RelatedIndex, blogLoader *pkg.BlogLoader, shares pkg.ShareLinker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path, ok := apiPath(c, blogLoader, shares)
+		if !ok {
+			return
+		}
+		if related == nil {
+			c.JSON(http.StatusOK, []pkg.LinkRef{})
+			return
+		}
+		c.JSON(http.StatusOK, related.Related(path))
+	}
+}
+
+// === handle graph json ===
+// private blogs are only visible to admin
+func GraphMiddleWare(links pkg.LinkGraph, config *pkg.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		admin := IsAdmin(c, config)
+		if admin {
+			c.Header("Cache-Control", "private, no-store")
+		}
+		c.JSON(http.StatusOK, links.Graph(admin))
+	}
+}
+
+// === handle archive json ===
+// counts of posts per month, ?year= and ?month= also list the posts
+func ArchiveJsonMiddleWare(apiCache pkg.Cache, blogLoader *pkg.BlogLoader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var year, month int
+		var err error
+		if q := c.Query("year"); q != "" {
+			if year, err = strconv.Atoi(q); err != nil || year < 1 {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": "year must be positive int",
+				})
+				return
+			}
+		}
+		if q := c.Query("month"); q != "" {
+			if month, err = strconv.Atoi(q); err != nil || month < 1 || month > 12 || year == 0 {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": "month must be 1-12 and used with year",
+				})
+				return
+			}
+		}
+		var archive *pkg.Archive
+		if archiveI, found := apiCache.Get(API_CACHE_ARCHIVE); found {
+			archive = archiveI.(*pkg.Archive)
+		} else {
+			if archive, err = blogLoader.Archive(); err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			apiCache.Set(API_CACHE_ARCHIVE, archive)
+		}
+		resp := struct {
+			Total  int                `json:"total"`
+			Months []pkg.ArchiveMonth `json:"months"`
+			Posts  []pkg.ArchivePost  `json:"posts,omitempty"`
+		}{Total: len(archive.Posts), Months: archive.Months()}
+		if year != 0 {
+			resp.Posts = archive.Filter(year, month)
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
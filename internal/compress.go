@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/easy-projects/easyblog/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// ===== compress =====
+
+// 压缩 json 等动态的响应,是否压缩在第一次写入时根据 Content-Type 决定,sse 不会被压缩
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding string
+	decided  bool
+	writer   io.WriteCloser
+}
+
+func (w *compressWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	status := w.ResponseWriter.Status()
+	if status == http.StatusNoContent || status == http.StatusNotModified ||
+		w.Header().Get("Content-Encoding") != "" || !pkg.Compressible(w.Header().Get("Content-Type")) {
+		return
+	}
+	writer, err := pkg.NewCompressWriter(w.ResponseWriter, w.encoding)
+	if err != nil {
+		log.Println("[compress] failed:", err)
+		return
+	}
+	w.Header().Set("Content-Encoding", w.encoding)
+	w.Header().Del("Content-Length")
+	w.writer = writer
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	w.decide()
+	if w.writer == nil {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.writer.Write(data)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compressWriter) Flush() {
+	if flusher, ok := w.writer.(interface{ Flush() error }); ok {
+		flusher.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressWriter) close() {
+	if w.writer != nil {
+		if err := w.writer.Close(); err != nil {
+			log.Println("[compress] close failed:", err)
+		}
+	}
+}
+
+// === handle compress ===
+func CompressMiddleWare(config *pkg.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		config.RLock()
+		compress := !config.NO_COMPRESS
+		config.RUnlock()
+		if !compress {
+			return
+		}
+		c.Header("Vary", "Accept-Encoding")
+		encoding := pkg.NegotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			return
+		}
+		writer := &compressWriter{ResponseWriter: c.Writer, encoding: encoding}
+		c.Writer = writer
+		defer func() {
+			writer.close()
+			c.Writer = writer.ResponseWriter
+		}()
+		c.Next()
+	}
+}
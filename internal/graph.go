@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+)
+
+// ===== graph page =====
+
+// 使用简单的力导向布局画出博客之间的链接,没有外部依赖;没有被链接的博客用红色标出
+const graphPage = `<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>graph</title>
+    <style>
+        body { margin: 0; font-family: sans-serif; }
+        #info { position: fixed; top: 8px; left: 8px; background: rgba(255, 255, 255, 0.85); padding: 4px 8px; }
+        canvas { display: block; }
+    </style>
+</head>
+<body>
+    <div id="info">loading...</div>
+    <canvas id="graph"></canvas>
+    <script>
+    (async function () {
+        const canvas = document.getElementById("graph");
+        const info = document.getElementById("info");
+        const ctx = canvas.getContext("2d");
+        const resize = () => { canvas.width = innerWidth; canvas.height = innerHeight; };
+        resize();
+        addEventListener("resize", resize);
+        const graph = await (await fetch("{{API_ROUTER}}/graph")).json();
+        const nodes = graph.nodes.map((n, i) => Object.assign(n, {
+            x: canvas.width / 2 + Math.cos(i) * 200 * Math.random(),
+            y: canvas.height / 2 + Math.sin(i) * 200 * Math.random(), vx: 0, vy: 0,
+        }));
+        const byUrl = new Map(nodes.map(n => [n.url, n]));
+        const edges = graph.edges.map(e => [byUrl.get(e.source), byUrl.get(e.target)]);
+        const orphans = nodes.filter(n => n.orphan).length;
+        info.textContent = nodes.length + " blogs, " + edges.length + " links, " + orphans + " orphans";
+        let hover = null;
+        function step() {
+            for (const a of nodes) {
+                for (const b of nodes) {
+                    if (a === b) continue;
+                    const dx = a.x - b.x, dy = a.y - b.y, d2 = dx * dx + dy * dy + 0.01;
+                    a.vx += dx / d2 * 200; a.vy += dy / d2 * 200;
+                }
+                a.vx += (canvas.width / 2 - a.x) * 0.001; a.vy += (canvas.height / 2 - a.y) * 0.001;
+            }
+            for (const [a, b] of edges) {
+                const dx = b.x - a.x, dy = b.y - a.y;
+                a.vx += dx * 0.005; a.vy += dy * 0.005; b.vx -= dx * 0.005; b.vy -= dy * 0.005;
+            }
+            for (const n of nodes) {
+                n.vx *= 0.85; n.vy *= 0.85; n.x += n.vx; n.y += n.vy;
+            }
+        }
+        function draw() {
+            step();
+            ctx.clearRect(0, 0, canvas.width, canvas.height);
+            ctx.strokeStyle = "#ccc";
+            for (const [a, b] of edges) {
+                ctx.beginPath(); ctx.moveTo(a.x, a.y); ctx.lineTo(b.x, b.y); ctx.stroke();
+            }
+            for (const n of nodes) {
+                ctx.fillStyle = n.orphan ? "#d33" : "#36c";
+                ctx.beginPath(); ctx.arc(n.x, n.y, 4 + Math.sqrt(n.in), 0, 2 * Math.PI); ctx.fill();
+                if (n === hover || nodes.length < 50) {
+                    ctx.fillStyle = "#333";
+                    ctx.fillText(n.title, n.x + 8, n.y + 4);
+                }
+            }
+            requestAnimationFrame(draw);
+        }
+        const nearest = e => nodes.find(n => (n.x - e.clientX) ** 2 + (n.y - e.clientY) ** 2 < 100);
+        canvas.addEventListener("mousemove", e => { hover = nearest(e); canvas.style.cursor = hover ? "pointer" : ""; });
+        canvas.addEventListener("click", e => { const n = nearest(e); if (n) location.href = n.url; });
+        draw();
+    })();
+    </script>
+</body>
+</html>
+`
+
+// === handle graph page ===
+func GraphPageMiddleWare(config *pkg.Config) gin.HandlerFunc {
+	config.RLock()
+	page := []byte(strings.ReplaceAll(graphPage, "{{API_ROUTER}}", config.API_ROUTER))
+	config.RUnlock()
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+	}
+}
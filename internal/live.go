@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/easy-projects/easyblog/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// ===== live reload =====
+
+// 浏览器通过 sse 订阅正在查看的页面,页面或其所在目录变化时收到通知
+type LiveHub struct {
+	mux     *sync.RWMutex
+	clients map[chan string]string
+}
+
+func NewLiveHub(bus pkg.EventBus, hide, private pkg.GitIgnorer) *LiveHub {
+	hub := &LiveHub{mux: &sync.RWMutex{}, clients: make(map[chan string]string)}
+	events := bus.Subscribe("live")
+	go func() {
+		for events := range events {
+			for _, event := range events {
+				for _, path := range []string{event.Path, event.OldPath} {
+					if path != "" && !pkg.PathMatch(path, hide, private) {
+						hub.notify(path)
+					}
+				}
+			}
+		}
+		hub.mux.Lock()
+		for ch := range hub.clients {
+			close(ch)
+		}
+		hub.clients = make(map[chan string]string)
+		hub.mux.Unlock()
+	}()
+	return hub
+}
+
+func (hub *LiveHub) notify(changed string) {
+	dir := pkg.SimplifyPath(filepath.Dir(changed))
+	hub.mux.RLock()
+	defer hub.mux.RUnlock()
+	for ch, watched := range hub.clients {
+		// 页面本身,目录页面中的条目,或文件所在的目录发生变化
+		if changed == watched || dir == watched || changed == pkg.SimplifyPath(filepath.Dir(watched)) {
+			select {
+			case ch <- changed:
+			default:
+			}
+		}
+	}
+}
+
+func (hub *LiveHub) subscribe(path string) chan string {
+	ch := make(chan string, 1)
+	hub.mux.Lock()
+	hub.clients[ch] = path
+	hub.mux.Unlock()
+	return ch
+}
+
+func (hub *LiveHub) unsubscribe(ch chan string) {
+	hub.mux.Lock()
+	if _, found := hub.clients[ch]; found {
+		delete(hub.clients, ch)
+		close(ch)
+	}
+	hub.mux.Unlock()
+}
+
+// === handle live reload ===
+func LiveReloadMiddleWare(hub *LiveHub, blogLoader *pkg.BlogLoader) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		path, err := blogLoader.Url2Path(c.Query("path"))
+		if err != nil || blogLoader.PathMatch(path, blogLoader.Hide, blogLoader.Private) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		log.Println("[live] watch:", path)
+		ch := hub.subscribe(path)
+		defer hub.unsubscribe(ch)
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+		c.SSEvent("ready", c.Query("path"))
+		c.Writer.Flush()
+		for {
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case changed, ok := <-ch:
+				if !ok {
+					return
+				}
+				c.SSEvent("change", blogLoader.Path2Url(changed))
+				c.Writer.Flush()
+			}
+		}
+	}
+}
+
+// 重新加载页面,并在加载后恢复滚动位置
+const liveReloadScript = `<script>
+(function () {
+    var key = "eb-live-scroll:" + location.pathname;
+    var y = sessionStorage.getItem(key);
+    if (y !== null) {
+        sessionStorage.removeItem(key);
+        window.addEventListener("load", function () { window.scrollTo(0, Number(y)); });
+    }
+    // pathname is percent-encoded already, the server decodes the query only once
+    var path = location.pathname;
+    try { path = decodeURIComponent(path); } catch (e) {}
+    var source = new EventSource("{{API_ROUTER}}/live?path=" + encodeURIComponent(path));
+    source.addEventListener("change", function () {
+        sessionStorage.setItem(key, String(window.scrollY));
+        location.reload();
+    });
+})();
+</script>
+`
+
+// 在开发模式下,把实时刷新的脚本插入到页面中,生成的静态页面中不会有
+func InjectLiveReload(blog *pkg.BlogItem, config *pkg.Config) []byte {
+	html := []byte(blog.Html)
+	config.RLock()
+	live, apiRouter := config.LIVE_RELOAD, config.API_ROUTER
+	config.RUnlock()
+	if !live || !(blog.IsDir() || blog.IsMd()) {
+		return html
+	}
+	script := []byte(strings.ReplaceAll(liveReloadScript, "{{API_ROUTER}}", apiRouter))
+	if i := bytes.LastIndex(html, []byte("</body>")); i >= 0 {
+		return append(append(append([]byte{}, html[:i]...), script...), html[i:]...)
+	}
+	return append(append([]byte{}, html...), script...)
+}
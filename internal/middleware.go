@@ -1,11 +1,20 @@
 package internal
 
 import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
 	"fmt"
+	"html"
+	"io"
+	"io/fs"
 	"net/http"
+	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	fsutil "github.com/cncsmonster/gofsutil"
 	"github.com/didip/tollbooth"
@@ -46,24 +55,23 @@ func LimitMiddleware(lmts ...*limiter.Limiter) gin.HandlerFunc {
 }
 
 // === cache ===
-func BlogCacheMiddleware(blogCache pkg.Cache, config *pkg.Config) gin.HandlerFunc {
+func BlogCacheMiddleware(blogCache pkg.Cache, blogLoader *pkg.BlogLoader, config *pkg.Config) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		url := c.Request.URL.Path
-		config.RLock()
-		path := config.BLOG_PATH + "/" + url[len(config.BLOG_ROUTER)+1:]
-		config.RUnlock()
-		path = pkg.SimplifyPath(path)
-		blogI, found := blogCache.Get(path)
-		var contentType string
-		if strings.HasSuffix(c.Request.URL.Path, ".png") || strings.HasSuffix(c.Request.URL.Path, ".jpg") || strings.HasSuffix(c.Request.URL.Path, ".jpeg") {
-			contentType = fmt.Sprintf("image/%s", filepath.Ext(c.Request.URL.Path)[1:])
-		} else {
-			contentType = "text/html; charset=utf-8"
+		path, err := blogLoader.Url2Path(url)
+		if err != nil {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
 		}
+		if _, custom, _ := DirOptions(c); custom {
+			// listings with custom sort or page are not cached
+			return
+		}
+		blogI, found := blogCache.Get(path)
 		if found {
 			log.Println("[cache] hit:", path)
 			blog := blogI.(*pkg.BlogItem)
-			c.Data(http.StatusOK, contentType, []byte(blog.Html))
+			ServeBlog(c, blog, config)
 			c.Abort()
 			return
 		}
@@ -73,30 +81,210 @@ func BlogCacheMiddleware(blogCache pkg.Cache, config *pkg.Config) gin.HandlerFun
 
 // === handle content ===
 
-func LoadBlogMiddleware(blogCache pkg.Cache, blogLoader *pkg.BlogLoader) func(c *gin.Context) {
+func LoadBlogMiddleware(blogCache pkg.Cache, blogLoader *pkg.BlogLoader, config *pkg.Config) func(c *gin.Context) {
 	return func(c *gin.Context) {
 		url := c.Request.URL.Path
-		filePath := blogLoader.Url2Path(url)
+		filePath, err := blogLoader.Url2Path(url)
+		if err != nil {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		options, custom, err := DirOptions(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		log.Println("[load blog] path:", filePath)
-		blog, err := blogLoader.LoadBlog(filePath)
+		blog, err := blogLoader.LoadBlogWith(filePath, options)
 		if err != nil {
 			c.AbortWithError(http.StatusNotFound, err)
 			return
 		}
-		blogCache.Set(filePath, blog)
-		file := []byte(blog.Html)
-		c.Data(http.StatusOK, "text/html; charset=utf-8", file)
+		if !blog.Streamed && !custom {
+			blogCache.Set(filePath, blog)
+		}
+		ServeBlog(c, blog, config)
+	}
+}
+
+// sort and page in query string change dir listings, custom is true when any of them is given
+func DirOptions(c *gin.Context) (options pkg.DirOptions, custom bool, err error) {
+	sort, hasSort := c.GetQuery("sort")
+	page, hasPage := c.GetQuery("page")
+	if !hasSort && !hasPage {
+		return options, false, nil
+	}
+	if !pkg.ValidDirSort(sort) {
+		return options, true, errors.New("sort must be one of name, date, weight, optionally prefixed with -")
+	}
+	options.Sort = sort
+	if hasPage {
+		n, err := strconv.Atoi(page)
+		if err != nil || n < 1 {
+			return options, true, errors.New("page must be positive int")
+		}
+		options.Page = n
+	}
+	return options, true, nil
+}
+
+// write blog with validators, respond 304 when the client already has it
+func ServeBlog(c *gin.Context, blog *pkg.BlogItem, config *pkg.Config) {
+	if blog.IsOther() {
+		ServeAsset(c, blog, config)
+		return
+	}
+	body := []byte(blog.Html)
+	// /api/live can not watch previews or private pages opened by a share link, the script would reconnect forever
+	if !c.GetBool("shared") && !c.GetBool("preview") {
+		body = InjectLiveReload(blog, config)
+	}
+	live := len(body) != len(blog.Html)
+	etag := blog.ETag
+	if live {
+		// the live reload script is part of the body
+		etag = etagVariant(etag, "live")
+	}
+	config.RLock()
+	cacheControl := config.CACHE_CONTROL_ASSET
+	if blog.IsDir() {
+		cacheControl = config.CACHE_CONTROL_DIR
+	} else if blog.IsMd() {
+		cacheControl = config.CACHE_CONTROL_MD
+	}
+	compress := !config.NO_COMPRESS
+	config.RUnlock()
+	if c.GetBool("shared") || c.GetBool("preview") {
+		cacheControl = "private, no-store"
+	}
+	encoding := ""
+	if compress {
+		c.Header("Vary", "Accept-Encoding")
+		if len(body) >= pkg.COMPRESS_MIN_SIZE {
+			encoding = pkg.NegotiateEncoding(c.GetHeader("Accept-Encoding"))
+		}
+	}
+	if encoding != "" {
+		var data []byte
+		var err error
+		if live {
+			data, err = pkg.Compress(body, encoding)
+		} else {
+			data, err = blog.Compressed(encoding)
+		}
+		if err != nil {
+			log.Println("[compress] failed:", blog.Path, err)
+		} else {
+			body = data
+			etag = etagVariant(etag, encoding)
+			c.Header("Content-Encoding", encoding)
+		}
+	}
+	c.Header("Cache-Control", cacheControl)
+	c.Header("ETag", etag)
+	if !blog.ModTime.IsZero() {
+		c.Header("Last-Modified", blog.ModTime.UTC().Format(http.TimeFormat))
+	}
+	if NotModified(c.Request, etag, blog.ModTime) {
+		c.Header("Content-Encoding", "")
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", body)
+}
+
+// serve non-markdown file, with mime detection and range support; large file is streamed from disk
+func ServeAsset(c *gin.Context, blog *pkg.BlogItem, config *pkg.Config) {
+	config.RLock()
+	cacheControl := config.CACHE_CONTROL_ASSET
+	compress := !config.NO_COMPRESS
+	config.RUnlock()
+	if c.GetBool("shared") || c.GetBool("preview") {
+		cacheControl = "private, no-store"
+	}
+	etag := blog.ETag
+	contentType := pkg.ContentType(blog.Path)
+	var content io.ReadSeeker = strings.NewReader(blog.Html)
+	if blog.Streamed {
+		file, err := blog.Open()
+		if err != nil {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		defer file.Close()
+		content = file
+	} else if compress && pkg.Compressible(contentType) {
+		c.Header("Vary", "Accept-Encoding")
+		if len(blog.Html) >= pkg.COMPRESS_MIN_SIZE {
+			if encoding := pkg.NegotiateEncoding(c.GetHeader("Accept-Encoding")); encoding != "" {
+				if data, err := blog.Compressed(encoding); err == nil {
+					content = bytes.NewReader(data)
+					etag = etagVariant(etag, encoding)
+					c.Header("Content-Encoding", encoding)
+				} else {
+					log.Println("[compress] failed:", blog.Path, err)
+				}
+			}
+		}
+	}
+	if contentType != "" {
+		c.Header("Content-Type", contentType)
+	}
+	c.Header("Cache-Control", cacheControl)
+	c.Header("ETag", etag)
+	// ServeContent handles Range, If-None-Match and If-Modified-Since
+	http.ServeContent(c.Writer, c.Request, filepath.Base(blog.Path), blog.ModTime, content)
+}
+
+// strong etag of another representation of the same content, e.g. "abc" -> "abc-gzip"
+func etagVariant(etag, suffix string) string {
+	if len(etag) < 2 {
+		return etag
+	}
+	return etag[:len(etag)-1] + "-" + suffix + `"`
+}
+
+// check If-None-Match and If-Modified-Since, If-None-Match takes precedence
+func NotModified(r *http.Request, etag string, modTime time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
 	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !modTime.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !modTime.Truncate(time.Second).After(t)
+	}
+	return false
 }
 
 // === handle private ===
-func PrivateMiddleWare(private pkg.GitIgnorer, config *pkg.Config) gin.HandlerFunc {
+func PrivateMiddleWare(private pkg.GitIgnorer, shares pkg.ShareLinker, blogLoader *pkg.BlogLoader) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		url := c.Request.URL.Path
-		path := config.BLOG_PATH + "/" + url[len(config.BLOG_ROUTER)+1:]
-		path = pkg.SimplifyPath(path)
+		path, err := blogLoader.Url2Path(url)
+		if err != nil {
+			log.Println("[check private] bad url:", url, err)
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
 		log.Println("[check private] path:", path)
-		if pkg.PathMatch(path, private) {
+		if blogLoader.PathMatch(path, private) {
+			if token := c.Query("share"); token != "" && shares.Verify(url, token) {
+				log.Println("[check private] path shared:", path)
+				// shared private blog should never be generated
+				c.Set("shared", true)
+				return
+			}
 			log.Println("[check private] path match private:", path)
 			c.AbortWithStatus(http.StatusNotFound)
 			return
@@ -104,43 +292,198 @@ func PrivateMiddleWare(private pkg.GitIgnorer, config *pkg.Config) gin.HandlerFu
 	}
 }
 
+// === handle admin ===
+func AdminMiddleWare(config *pkg.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsAdmin(c, config) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "unauthorized",
+			})
+			return
+		}
+	}
+}
+
+// the request carries the admin token
+func IsAdmin(c *gin.Context, config *pkg.Config) bool {
+	config.RLock()
+	token := config.ADMIN_TOKEN
+	config.RUnlock()
+	auth := c.GetHeader("Authorization")
+	return token != "" && subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+token)) == 1
+}
+
+// === handle share ===
+func ShareMiddleWare(shares pkg.ShareLinker, config *pkg.Config) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var req struct {
+			Url      string `json:"url"`
+			Expire   string `json:"expire"`
+			MaxViews int    `json:"max_views"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if !strings.HasPrefix(req.Url, config.BLOG_ROUTER+"/") {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "url must be under " + config.BLOG_ROUTER,
+			})
+			return
+		}
+		ttl := 24 * time.Hour
+		if req.Expire != "" {
+			d, err := time.ParseDuration(req.Expire)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": "expire must be duration",
+				})
+				return
+			}
+			ttl = d
+		}
+		link, err := shares.Create(req.Url, ttl, req.MaxViews)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		log.Println("[share] create:", link.String())
+		c.JSON(http.StatusOK, link)
+	}
+}
+
 // === handle gen ===
-func GenMiddleWare(blogCache pkg.Cache, config *pkg.Config) gin.HandlerFunc {
+func GenMiddleWare(blogCache pkg.Cache, blogLoader *pkg.BlogLoader, config *pkg.Config) gin.HandlerFunc {
+	// external links are not probed while generating
+	checker := pkg.NewLinkChecker(blogLoader, nil, "")
 	return func(c *gin.Context) {
 		config.RLock()
-		not_gen := config.NOT_GEN
-		blogPath := config.BLOG_PATH
-		blogRouter := config.BLOG_ROUTER
+		not_gen, check_links := config.NOT_GEN, config.CHECK_LINKS
 		config.RUnlock()
 
-		if not_gen {
+		if _, custom, _ := DirOptions(c); not_gen || custom || c.GetBool("shared") {
 			return
 		}
 		URL := c.Request.URL.Path
-		path := blogPath + "/" + URL[len(blogRouter)+1:]
-		path = pkg.SimplifyPath(path)
+		path, err := blogLoader.Url2Path(URL)
+		if err != nil {
+			return
+		}
 		c.Next()
 		c.Abort()
 		if c.Writer.Status() == http.StatusOK {
-			gen_path := pkg.GenPath(URL, config)
+			gen_path, err := pkg.GenPath(URL, config)
+			if err != nil {
+				log.Println("[gen] bad gen path:", URL, err)
+				return
+			}
 			log.Println("[gen] gen:", gen_path)
 			blogI, found := blogCache.Get(path)
 			if !found {
-				log.Println("[gen] blog not found in cache:", URL)
+				if stat, err := os.Stat(path); err != nil || stat.IsDir() || pkg.IsMdPath(path) {
+					log.Println("[gen] blog not found in cache:", URL)
+					return
+				}
+				// large asset is not cached, copy it from disk
+				if err := copyFile(path, gen_path); err != nil {
+					log.Println("[gen] copy failed:", URL, err)
+				}
 				return
 			}
 			blog := blogI.(*pkg.BlogItem)
+			if blog.IsDir() {
+				// static hosting ignores ?page=, a generated listing holds every item
+				if all, err := blogLoader.LoadBlogWith(path, pkg.DirOptions{All: true}); err == nil {
+					blog = all
+				}
+			}
 			var file []byte = []byte(blog.Html)
-			if blog.IsDir() || blog.IsMd() {
+			if blog.IsProtected() {
+				// links of protected blog are encrypted, transform them before encryption
+				plain, err := pkg.UnprotectHtml(file, blog.Password)
+				if err != nil {
+					log.Println("[gen] unprotect failed:", gen_path, err)
+					return
+				}
+				if check_links {
+					warnLinks(checker, blog.Path, plain)
+				}
+				file, err = pkg.ProtectHtml(pkg.TransformLinks(plain, config), blog.Title, blog.Password)
+				if err != nil {
+					log.Println("[gen] protect failed:", gen_path, err)
+					return
+				}
+			} else if blog.IsDir() || blog.IsMd() {
+				if check_links {
+					warnLinks(checker, blog.Path, file)
+				}
 				file = pkg.TransformLinks(file, config)
 			}
 			if err := fsutil.MustWrite(gen_path, file); err != nil {
-				panic(err)
+				log.Println("[gen] write failed:", gen_path, err)
+				return
+			}
+			contentType := "text/html"
+			if blog.IsOther() {
+				contentType = pkg.ContentType(blog.Path)
 			}
+			genCompressed(gen_path, file, len(file) >= pkg.COMPRESS_MIN_SIZE && pkg.Compressible(contentType), config)
+		}
+	}
+}
+
+func warnLinks(checker pkg.LinkChecker, path string, page []byte) {
+	for _, issue := range checker.CheckPage(path, page) {
+		log.Println("[gen] link warning:", issue)
+	}
+}
+
+// write precompressed files next to the generated file, so static servers can serve them directly;
+// stale ones are removed
+func genCompressed(gen_path string, file []byte, compressible bool, config *pkg.Config) {
+	config.RLock()
+	encodings := config.GEN_COMPRESS
+	config.RUnlock()
+	for encoding, ext := range pkg.EncodingExts {
+		if !compressible || !slices.Contains(encodings, encoding) {
+			os.Remove(gen_path + ext)
+			continue
+		}
+		data, err := pkg.Compress(file, encoding)
+		if err != nil {
+			log.Println("[gen] compress failed:", gen_path, err)
+			continue
+		}
+		if err := fsutil.MustWrite(gen_path+ext, data); err != nil {
+			log.Println("[gen] write failed:", gen_path, err)
 		}
 	}
 }
 
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 // === handle search ===
 func SearchMiddleWare(searchers map[string]pkg.Searcher, cache pkg.Cache, config *pkg.Config) func(c *gin.Context) {
 	return func(c *gin.Context) {
@@ -175,16 +518,50 @@ func SearchMiddleWare(searchers map[string]pkg.Searcher, cache pkg.Cache, config
 			})
 			return
 		}
-		results, err := searcher.Search(keyword, num)
+		offset := 0
+		if n, find := c.GetQuery("offset"); find {
+			n, err := strconv.Atoi(n)
+			if err != nil || n < 0 {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": "offset must be non-negative int",
+				})
+				return
+			}
+			offset = n
+		}
+		var results []pkg.SearchResult
+		var err error
+		if detailSearcher, ok := searcher.(pkg.DetailSearcher); ok {
+			results, err = detailSearcher.SearchDetail(keyword, num, offset)
+		} else {
+			var paths []string
+			paths, err = searcher.Search(keyword, num+offset)
+			if offset < len(paths) {
+				paths = paths[offset:]
+			} else {
+				paths = nil
+			}
+			for _, path := range paths {
+				results = append(results, pkg.SearchResult{Path: path})
+			}
+		}
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
+		type JsonResult struct {
+			Url     string  `json:"url"`
+			Title   string  `json:"title,omitempty"`
+			Snippet string  `json:"snippet,omitempty"`
+			Score   float64 `json:"score,omitempty"`
+		}
 		retResults := make([]string, 0, len(results))
+		detailResults := make([]JsonResult, 0, len(results))
 		// convert file paths to links
-		for _, path := range results {
+		for _, result := range results {
+			path := result.Path
 			if path == "" || len(path) < len(config.BLOG_PATH) {
 				log.Println("[search] result  path:", path, "is empty or too short")
 				continue
@@ -193,7 +570,164 @@ func SearchMiddleWare(searchers map[string]pkg.Searcher, cache pkg.Cache, config
 			path = config.BLOG_ROUTER + path[len(config.BLOG_PATH):]
 			path = pkg.SimplifyPath(path)
 			retResults = append(retResults, path)
+			detailResults = append(detailResults, JsonResult{Url: path, Title: result.Title, Snippet: result.Snippet, Score: result.Score})
+		}
+		if c.Query("detail") != "" {
+			c.JSON(http.StatusOK, detailResults)
+			return
 		}
 		c.JSON(http.StatusOK, retResults)
 	}
 }
+
+// === handle archive ===
+// serve date-based archive pages under BLOG_ROUTER/archive/, unless the blog has its own archive file or dir
+func ArchiveMiddleWare(archiveCache pkg.Cache, blogLoader *pkg.BlogLoader, config *pkg.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		config.RLock()
+		blogRouter, not_gen := config.BLOG_ROUTER, config.NOT_GEN
+		config.RUnlock()
+		URL := c.Request.URL.Path
+		year, month, redirect, ok := pkg.ParseArchiveUrl(URL, blogRouter)
+		if !ok || !blogLoader.HasArchive() {
+			return
+		}
+		c.Abort()
+		if redirect != "" {
+			c.Redirect(http.StatusMovedPermanently, redirect)
+			return
+		}
+		if blogI, found := archiveCache.Get(URL); found {
+			ServeBlog(c, blogI.(*pkg.BlogItem), config)
+			return
+		}
+		blog, err := blogLoader.LoadArchive(year, month)
+		if errors.Is(err, fs.ErrNotExist) {
+			// no posts in that year or month, or a page missing from a bundle
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		} else if err != nil {
+			log.Println("[archive] load failed:", URL, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		archiveCache.Set(URL, blog)
+		ServeBlog(c, blog, config)
+		if not_gen {
+			return
+		}
+		gen_path, err := pkg.GenPath(URL, config)
+		if err != nil {
+			log.Println("[gen] bad gen path:", URL, err)
+			return
+		}
+		log.Println("[gen] gen:", gen_path)
+		file := pkg.TransformLinks([]byte(blog.Html), config)
+		if err := fsutil.MustWrite(gen_path, file); err != nil {
+			log.Println("[gen] write failed:", gen_path, err)
+			return
+		}
+		genCompressed(gen_path, file, len(file) >= pkg.COMPRESS_MIN_SIZE, config)
+	}
+}
+
+// === handle history ===
+// ?history lists revisions of a post, ?diff=a..b shows what changed between two of them;
+// view=split shows the diff side by side
+func HistoryMiddleWare(revisions pkg.RevisionStore, blogLoader *pkg.BlogLoader, config *pkg.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, history := c.GetQuery("history")
+		diff, hasDiff := c.GetQuery("diff")
+		if !history && !hasDiff {
+			return
+		}
+		c.Abort()
+		config.RLock()
+		access := config.HISTORY
+		config.RUnlock()
+		admin := IsAdmin(c, config)
+		if access == "" || (access == pkg.ACCESS_ADMIN && !admin) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		url := c.Request.URL.Path
+		path, err := blogLoader.Url2Path(url)
+		if err != nil || !pkg.IsMdPath(path) || blogLoader.PathMatch(path, blogLoader.Hide) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		content, err := blogLoader.ReadFile(path)
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		meta, _ := pkg.MdMeta(content)
+		if meta.Password != "" && !admin {
+			// old revisions of protected posts are not encrypted
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		if meta.Title == "" {
+			meta.Title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+		var title string
+		var md []byte
+		if hasDiff {
+			from, to, found := strings.Cut(diff, "..")
+			if !found || from == "" || to == "" {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": "diff must be like a..b",
+				})
+				return
+			}
+			old, err := revisions.Content(path, from)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+					"error": "revision not found: " + from,
+				})
+				return
+			}
+			new, err := revisions.Content(path, to)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+					"error": "revision not found: " + to,
+				})
+				return
+			}
+			// a revision may have been protected even if the post is not any more
+			if !admin && (isProtectedMd(old) || isProtectedMd(new)) {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			title = fmt.Sprintf("%s (%s..%s)", meta.Title, from, to)
+			md = []byte(fmt.Sprintf("<p><a href=\"%s?history\">history</a></p>\n", html.EscapeString(url)))
+			if c.Query("view") == "split" {
+				md = append(md, pkg.RenderSplitDiff(pkg.Diff(old, new))...)
+			} else {
+				md = append(md, pkg.RenderUnifiedDiff(pkg.Diff(old, new))...)
+			}
+		} else {
+			list, err := revisions.Revisions(path)
+			if err != nil {
+				log.Println("[history] revisions failed:", path, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			title = "History of " + meta.Title
+			md = pkg.RenderHistory(list, url)
+		}
+		page, err := blogLoader.RenderPage(md, title)
+		if err != nil {
+			log.Println("[history] render failed:", path, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Header("Cache-Control", "private, no-cache")
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+	}
+}
+
+func isProtectedMd(content []byte) bool {
+	meta, _ := pkg.MdMeta(content)
+	return meta.Password != ""
+}
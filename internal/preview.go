@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/easy-projects/easyblog/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// ===== preview =====
+
+// serve the blog as it is at a git branch, tag or commit under BLOG_ROUTER@<ref>/,
+// files are read from the git object store, a ref containing / must be escaped as %2F;
+// previews maps the commit and ref to the loader reading that commit
+func PreviewMiddleWare(previews pkg.Cache, blogLoader *pkg.BlogLoader, config *pkg.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		config.RLock()
+		access, blogRouter, blogPath, gitignore := config.PREVIEW, config.BLOG_ROUTER, config.BLOG_PATH, config.HONOR_GITIGNORE
+		config.RUnlock()
+		escaped := c.Request.URL.EscapedPath()
+		if !strings.HasPrefix(escaped, blogRouter+"@") {
+			return
+		}
+		c.Abort()
+		admin := IsAdmin(c, config)
+		if access == "" || (access == pkg.ACCESS_ADMIN && !admin) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		rawRef, rest, found := strings.Cut(escaped[len(blogRouter)+1:], "/")
+		if !found {
+			c.Redirect(http.StatusMovedPermanently, escaped+"/")
+			return
+		}
+		ref, err := url.PathUnescape(rawRef)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "bad ref: " + rawRef,
+			})
+			return
+		}
+		rel, err := url.PathUnescape(rest)
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		commit, err := pkg.ResolveGitRef(blogPath, ref)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		var loader *pkg.BlogLoader
+		key := commit + "@" + rawRef
+		if cached, found := previews.Get(key); found {
+			loader = cached.(*pkg.BlogLoader)
+		} else {
+			fsys, err := pkg.NewGitFS(blogPath, commit)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			// the ignore files at the ref apply on top of the working tree's rules
+			hide, private := pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer()
+			if err := pkg.LoadIgnoreFilesFS(fsys, blogPath, hide, private, gitignore); err != nil {
+				log.Println("[preview] load ignore files failed:", ref, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			log.Println("[preview] load:", ref, commit)
+			loader = blogLoader.WithFS(fsys, blogRouter+"@"+rawRef)
+			loader.Hide = pkg.UnionIgnorer(hide, loader.Hide)
+			loader.Private = pkg.UnionIgnorer(private, loader.Private)
+			previews.Set(key, loader)
+		}
+		path, err := loader.Url2Path(loader.BlogRouter + "/" + rel)
+		if err != nil || pkg.PathMatch(path, loader.Hide) || (!admin && pkg.PathMatch(path, loader.Private)) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		options, _, err := DirOptions(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		blog, err := loader.LoadBlogWith(path, options)
+		if err != nil {
+			c.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		// a branch moves, never let anyone cache its pages
+		c.Set("preview", true)
+		ServeBlog(c, blog, config)
+	}
+}
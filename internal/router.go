@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"io"
 	"net/http"
 	"path/filepath"
 	"sync"
@@ -15,45 +16,205 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RouteApp(r *gin.Engine, config *pkg.Config, spider fspider.Spider) {
+// RouteApp returns a function to release resources of the app, it should be called when server stops;
+// blogFS is where blog files are read from, nil means BLOG_PATH on disk
+func RouteApp(r *gin.Engine, config *pkg.Config, spider fspider.Spider, blogFS pkg.BlogFS) (shutdown func()) {
 	blogCache := pkg.NewCache(1000)
+	apiCache := pkg.NewCache(1000)
+	archiveCache := pkg.NewCache(100)
 	searcherCache := pkg.NewCache(1000)
 	searcherCacheLock := &sync.RWMutex{}
 	hideMatcher := pkg.NewBlogIgnorer().AddPatterns(config.HIDE_PATHS...)
 	privateMatcher := pkg.NewBlogIgnorer().AddPatterns(config.PRIVATE_PATHS...)
+	shares := pkg.NewShareLinker(config.APP_DATA_PATH)
+	fsys := blogFS
+	if fsys == nil {
+		fsys = pkg.OsFS
+	}
+	if err := pkg.LoadIgnoreFilesFS(fsys, config.BLOG_PATH, hideMatcher, privateMatcher, config.HONOR_GITIGNORE); err != nil {
+		log.Println("[ignore] load ignore files failed:", err)
+	}
+	links := pkg.NewLinkGraphFS(fsys, config.BLOG_PATH, config.BLOG_ROUTER, hideMatcher, privateMatcher)
 	blogLoader := &pkg.BlogLoader{
-		RWMutex:       &sync.RWMutex{},
-		BlogPath:      config.BLOG_PATH,
-		BlogRouter:    config.BLOG_ROUTER,
-		TemplatePath:  config.TEMPLATE_PATH,
-		RenderCommand: config.RENDER_COMMAND,
-		Hide:          hideMatcher,
-		Private:       privateMatcher,
+		RWMutex:         &sync.RWMutex{},
+		BlogPath:        config.BLOG_PATH,
+		BlogRouter:      config.BLOG_ROUTER,
+		TemplatePath:    config.TEMPLATE_PATH,
+		RenderCommand:   config.RENDER_COMMAND,
+		FragmentCommand: config.FRAGMENT_COMMAND,
+		SymlinkPolicy:   config.SYMLINK_POLICY,
+		MaxCacheSize:    config.MAX_CACHE_SIZE,
+		DirPageSize:     config.DIR_PAGE_SIZE,
+		Hide:            hideMatcher,
+		Private:         privateMatcher,
+		Links:           links,
+		FS:              blogFS,
+	}
+	// a bundle carries pages rendered when it was built and the documents to index, see eb bundle
+	prebuilt := config.BLOG_FS == pkg.BLOG_FS_EMBED && pkg.EmbeddedRendered != nil
+	if prebuilt {
+		blogLoader.Rendered = pkg.NewBlogFS(pkg.EmbeddedRendered, config.BLOG_PATH)
+	}
+	// created/updated dates and authors from history, nil when BLOG_PATH is not in a git repo;
+	// history, snapshots and previews need the blog on disk
+	var gitMeta pkg.GitMeta
+	if blogFS == nil {
+		gitMeta = pkg.NewGitMeta(config.BLOG_PATH, config.APP_DATA_PATH)
+	} else if config.HISTORY != "" || config.PREVIEW != "" {
+		log.Println("[config] history and preview need blog_fs = \"os\", disabled")
+	}
+	if gitMeta != nil {
+		blogLoader.Git = gitMeta
+	}
+	var related pkg.RelatedIndex
+	if config.RELATED_NUM > 0 {
+		related = pkg.NewRelatedIndexFS(fsys, config.BLOG_PATH, config.BLOG_ROUTER, hideMatcher, privateMatcher, config.RELATED_NUM)
+		blogLoader.Related = related
 	}
 
-	go func() {
-		Changed := spider.FilesChanged()
-		for path := range Changed {
-			path = pkg.SimplifyPath(path)
-			log.Println("[cache] remove:", path)
+	// listings show child counts and index.md titles of subdirectories, so a change affects every ancestor
+	blogPath := pkg.SimplifyPath(config.BLOG_PATH)
+	evict := func(path string) {
+		for {
 			blogCache.Remove(path)
-			dir := filepath.Dir(path)
-			log.Println("[cache] remove:", dir)
-			blogCache.Remove(dir)
+			apiCache.Remove(path)
+			dir := pkg.SimplifyPath(filepath.Dir(path))
+			if path == blogPath || dir == path {
+				return
+			}
+			path = dir
+		}
+	}
+	// all file changes are delivered through the bus
+	bus := pkg.NewEventBus(spider, 100*time.Millisecond)
+	go func() {
+		for events := range bus.Subscribe("cache") {
+			for _, event := range events {
+				apiCache.Remove(API_CACHE_TREE)
+				// dates or titles of posts may change, archive pages are cheap to rebuild
+				apiCache.Remove(API_CACHE_ARCHIVE)
+				archiveCache.RemoveAll()
+				for _, path := range []string{event.Path, event.OldPath} {
+					if path == "" {
+						continue
+					}
+					log.Println("[cache] remove:", path)
+					evict(path)
+				}
+			}
 		}
 		log.Println("[cache] finished")
 	}()
+	// keep the link graph current, pages whose backlinks or wiki-links changed must be rendered again
+	linkEvents := bus.Subscribe("links")
+	links.Build(spider.AllPaths())
+	go func() {
+		for events := range linkEvents {
+			var affected []string
+			for _, event := range events {
+				if event.Kind == pkg.FILE_RENAMED {
+					affected = append(affected, links.Remove(event.OldPath)...)
+				}
+				if event.Kind == pkg.FILE_DELETED {
+					affected = append(affected, links.Remove(event.Path)...)
+				} else {
+					affected = append(affected, links.Update(event.Path)...)
+				}
+			}
+			for _, path := range affected {
+				log.Println("[links] remove:", path)
+				evict(path)
+			}
+		}
+	}()
+	// related posts change with the content of other posts
+	if related != nil {
+		relatedEvents := bus.Subscribe("related")
+		related.Build(spider.AllPaths())
+		go func() {
+			for events := range relatedEvents {
+				var affected []string
+				for _, event := range events {
+					if event.Kind == pkg.FILE_RENAMED {
+						affected = append(affected, related.Remove(event.OldPath)...)
+					}
+					if event.Kind == pkg.FILE_DELETED {
+						affected = append(affected, related.Remove(event.Path)...)
+					} else {
+						affected = append(affected, related.Update(event.Path)...)
+					}
+				}
+				for _, path := range affected {
+					log.Println("[related] remove:", path)
+					blogCache.Remove(path)
+					apiCache.Remove(path)
+				}
+			}
+		}()
+	}
+	// a commit changes the history of the committed files, check for new commits when files change
+	if gitMeta != nil {
+		go func() {
+			for range bus.Subscribe("git") {
+				changed := gitMeta.Refresh()
+				if len(changed) > 0 {
+					apiCache.Remove(API_CACHE_ARCHIVE)
+					archiveCache.RemoveAll()
+				}
+				for _, path := range changed {
+					log.Println("[git] remove:", path)
+					blogCache.Remove(path)
+					apiCache.Remove(path)
+				}
+			}
+		}()
+	}
+	// revisions of posts for ?history and ?diff, without git a snapshot is saved whenever a post changes
+	var revisions pkg.RevisionStore
+	if config.HISTORY != "" && blogFS == nil {
+		revisions = pkg.NewRevisionStore(config.BLOG_PATH, config.APP_DATA_PATH)
+		historyEvents := bus.Subscribe("history")
+		go func() {
+			for _, path := range spider.AllPaths() {
+				path = pkg.SimplifyPath(path)
+				if pkg.IsMdPath(path) && !pkg.PathMatch(path, hideMatcher) {
+					if err := revisions.Snapshot(path); err != nil {
+						log.Println("[history] snapshot failed:", path, err)
+					}
+				}
+			}
+			for events := range historyEvents {
+				for _, event := range events {
+					if event.Kind == pkg.FILE_DELETED || !pkg.IsMdPath(event.Path) || pkg.PathMatch(event.Path, hideMatcher) {
+						continue
+					}
+					if err := revisions.Snapshot(event.Path); err != nil {
+						log.Println("[history] snapshot failed:", event.Path, err)
+					}
+				}
+			}
+		}()
+	}
 	// set visit rate limit for each ip and each path
 	lmt1 := tollbooth.NewLimiter(float64(config.RATE_LIMITE_SECOND), &limiter.ExpirableOptions{DefaultExpirationTTL: time.Second}) // 每秒最多5次
 	lmt2 := tollbooth.NewLimiter(float64(config.RATE_LIMITE_MINUTE), &limiter.ExpirableOptions{DefaultExpirationTTL: time.Minute}) // 每分钟最多30次
 	lmt3 := tollbooth.NewLimiter(float64(config.RATE_LIMITE_HOUR), &limiter.ExpirableOptions{DefaultExpirationTTL: time.Hour})     // 每小时最多1000次
 
 	// for searchers
-	blogIndexer := pkg.NewBlogIndexer(config.APP_DATA_PATH + "/" + "blog.bleve")
-	go func() {
+	var blogIndexer pkg.BlogIndexer
+	if prebuilt {
+		blogIndexer = pkg.NewMemBlogIndexer()
+	} else {
+		blogIndexer = pkg.NewBlogIndexer(config.APP_DATA_PATH + "/" + "blog.bleve")
+	}
+	// held while indexing, so that a re-index after the rules change is not overtaken by a batch checked with the old rules
+	indexMux := &sync.Mutex{}
+	// index every visible path, and drop the others
+	reindex := func() {
 		for _, path := range spider.AllPaths() {
 			path = pkg.SimplifyPath(path)
 			if pkg.PathMatch(path, hideMatcher, privateMatcher) {
+				blogIndexer.Delete(&pkg.BlogItem{Path: path})
 				continue
 			}
 			blog, err := blogLoader.LoadBlog(path)
@@ -61,22 +222,41 @@ func RouteApp(r *gin.Engine, config *pkg.Config, spider fspider.Spider) {
 				blogIndexer.Add(blog)
 			}
 		}
-		Changed := spider.FilesChanged()
-		for path := range Changed {
+	}
+	// subscribe before the initial indexing, so that no change is missed
+	indexerEvents := bus.Subscribe("indexer")
+	go func() {
+		indexMux.Lock()
+		if prebuilt {
+			for _, doc := range pkg.EmbeddedIndex {
+				blogIndexer.Add(&pkg.BlogItem{Path: doc.Path, Meta: doc.Meta, File: doc.File})
+			}
+		} else {
+			reindex()
+		}
+		indexMux.Unlock()
+		for events := range indexerEvents {
+			indexMux.Lock()
 			searcherCacheLock.Lock()
 			searcherCache.RemoveAll()
 			searcherCacheLock.Unlock()
-			path := pkg.SimplifyPath(path)
-			if pkg.PathMatch(path, hideMatcher, privateMatcher) {
-				blogIndexer.Delete(&pkg.BlogItem{Path: path})
-				continue
-			}
-			blog, err := blogLoader.LoadBlog(path)
-			if err == nil {
-				blogIndexer.Add(blog)
-			} else {
-				blogIndexer.Delete(&pkg.BlogItem{Path: path})
+			for _, event := range events {
+				if event.Kind == pkg.FILE_RENAMED {
+					blogIndexer.Delete(&pkg.BlogItem{Path: event.OldPath})
+				}
+				path := event.Path
+				if event.Kind == pkg.FILE_DELETED || pkg.PathMatch(path, hideMatcher, privateMatcher) {
+					blogIndexer.Delete(&pkg.BlogItem{Path: path})
+					continue
+				}
+				blog, err := blogLoader.LoadBlog(path)
+				if err == nil {
+					blogIndexer.Add(blog)
+				} else {
+					blogIndexer.Delete(&pkg.BlogItem{Path: path})
+				}
 			}
+			indexMux.Unlock()
 		}
 	}()
 	searchers := map[string]pkg.Searcher{
@@ -90,9 +270,68 @@ func RouteApp(r *gin.Engine, config *pkg.Config, spider fspider.Spider) {
 			delete(searchers, plugin.Name)
 			continue
 		}
+		if blogFS != nil {
+			log.Println("[search] plugin", plugin.Name, "searches BLOG_PATH on disk, not blog_fs =", config.BLOG_FS)
+		}
 		searcher := pkg.NewSearcherByPlugin(plugin, hideMatcher, privateMatcher, config)
 		searchers[plugin.Name] = searcher
 	}
+	// notify plugins that care about file changes
+	go func() {
+		for events := range bus.Subscribe("plugins") {
+			for _, event := range events {
+				if pkg.PathMatch(event.Path, hideMatcher, privateMatcher) {
+					continue
+				}
+				for _, searcher := range searchers {
+					if notifier, ok := searcher.(pkg.ChangeNotifier); ok {
+						notifier.NotifyChanged(event.Path)
+					}
+				}
+			}
+		}
+	}()
+	// plugins get the rules of ignore files as the paths they match
+	notifyExclude := func() {
+		config.RLock()
+		patterns := append(append([]string{}, config.HIDE_PATHS...), config.PRIVATE_PATHS...)
+		config.RUnlock()
+		exclude := pkg.ExcludePatterns(spider.AllPaths(), config.BLOG_PATH, hideMatcher, privateMatcher, patterns...)
+		for _, searcher := range searchers {
+			if notifier, ok := searcher.(pkg.ExcludeNotifier); ok {
+				notifier.NotifyExclude(exclude)
+			}
+		}
+	}
+	notifyExclude()
+	// hide or private rules changed, anything built with the old rules may show newly hidden paths
+	ignoreEvents := bus.Subscribe("ignore")
+	go func() {
+		for events := range ignoreEvents {
+			reloaded := false
+			for _, event := range events {
+				if pkg.ReloadIgnoreFile(event.Path, hideMatcher, privateMatcher, config.HONOR_GITIGNORE) {
+					log.Println("[ignore] reload:", event.Path)
+					reloaded = true
+				}
+			}
+			if !reloaded {
+				continue
+			}
+			blogCache.RemoveAll()
+			apiCache.RemoveAll()
+			archiveCache.RemoveAll()
+			if !prebuilt {
+				indexMux.Lock()
+				reindex()
+				indexMux.Unlock()
+			}
+			searcherCacheLock.Lock()
+			searcherCache.RemoveAll()
+			searcherCacheLock.Unlock()
+			notifyExclude()
+		}
+	}()
 
 	r.Use(cors.Default())
 	r.Use(func(c *gin.Context) {
@@ -104,29 +343,98 @@ func RouteApp(r *gin.Engine, config *pkg.Config, spider fspider.Spider) {
 		}
 	})
 	r.Use(LimitMiddleware(lmt1, lmt2, lmt3))
+	if config.PREVIEW != "" && blogFS == nil {
+		r.Use(PreviewMiddleWare(pkg.NewCache(16), blogLoader, config))
+	}
 	// blog
 	blog := r.Group(config.BLOG_ROUTER)
-	blog.Use(PrivateMiddleWare(privateMatcher, config))
-	blog.Use(BlogCacheMiddleware(blogCache, config))
-	blog.Use(GenMiddleWare(blogCache, config))
-	blog.Use(LoadBlogMiddleware(blogCache, blogLoader))
+	blog.Use(ArchiveMiddleWare(archiveCache, blogLoader, config))
+	blog.Use(PrivateMiddleWare(privateMatcher, shares, blogLoader))
+	if revisions != nil {
+		blog.Use(HistoryMiddleWare(revisions, blogLoader, config))
+	}
+	blog.Use(BlogCacheMiddleware(blogCache, blogLoader, config))
+	blog.Use(GenMiddleWare(blogCache, blogLoader, config))
+	blog.Use(LoadBlogMiddleware(blogCache, blogLoader, config))
 	blog.GET("/*any")
 	// api
 	api := r.Group(config.API_ROUTER)
+	api.Use(CompressMiddleWare(config))
 	api.GET("/search", SearchMiddleWare(searchers, searcherCache, config))
+	api.GET("/blog", BlogJsonMiddleWare(apiCache, blogCache, blogLoader, shares))
+	api.GET("/dir", DirJsonMiddleWare(blogLoader, shares))
+	api.GET("/tree", TreeJsonMiddleWare(apiCache, blogLoader))
+	api.GET("/archive", ArchiveJsonMiddleWare(apiCache, blogLoader))
+	api.GET("/backlinks", BacklinksMiddleWare(links, blogLoader, shares))
+	api.GET("/graph", GraphMiddleWare(links, config))
+	api.GET("/related", RelatedMiddleWare(related, blogLoader, shares))
+	if config.GRAPH_PAGE {
+		r.GET("/graph", GraphPageMiddleWare(config))
+	}
+	if config.LIVE_RELOAD {
+		api.GET("/live", LiveReloadMiddleWare(NewLiveHub(bus, hideMatcher, privateMatcher), blogLoader))
+	}
 	api.GET("/searchers", func(c *gin.Context) {
 		type JsonSearcher struct {
-			Type  string `json:"type"`
-			Brief string `json:"brief"`
+			Type    string `json:"type"`
+			Brief   string `json:"brief"`
+			Healthy bool   `json:"healthy"`
 		}
 		jsonSearchers := make([]JsonSearcher, 0, len(searchers))
+		// probe plugins concurrently, so one dead plugin costs at most its own timeout
+		wg := &sync.WaitGroup{}
 		for _, searcher := range searchers {
 			jsonSearchers = append(jsonSearchers, JsonSearcher{
-				Type:  searcher.Name(),
-				Brief: searcher.Brief(),
+				Type:    searcher.Name(),
+				Brief:   searcher.Brief(),
+				Healthy: true,
 			})
+			if checker, ok := searcher.(pkg.HealthChecker); ok {
+				wg.Add(1)
+				go func(healthy *bool) {
+					defer wg.Done()
+					*healthy = checker.Healthy()
+				}(&jsonSearchers[len(jsonSearchers)-1].Healthy)
+			}
 		}
+		wg.Wait()
 		c.JSON(http.StatusOK, jsonSearchers)
 	})
-
+	// admin api
+	if config.ADMIN_TOKEN != "" {
+		admin := api.Group("/", AdminMiddleWare(config))
+		admin.POST("/share", ShareMiddleWare(shares, config))
+		admin.GET("/shares", func(c *gin.Context) {
+			links, err := shares.List()
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusOK, links)
+		})
+		admin.POST("/share/rotate", func(c *gin.Context) {
+			if err := shares.Rotate(); err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			c.Status(http.StatusNoContent)
+		})
+	}
+	return func() {
+		bus.Close()
+		for _, searcher := range searchers {
+			if closer, ok := searcher.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					log.Println("[shutdown] close searcher failed:", searcher.Name(), err)
+				}
+			}
+		}
+		if err := blogIndexer.Close(); err != nil {
+			log.Println("[shutdown] close indexer failed:", err)
+		}
+	}
 }
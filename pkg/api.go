@@ -0,0 +1,249 @@
+package pkg
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+	"github.com/google/shlex"
+	"golang.org/x/net/html"
+)
+
+// === json api ===
+
+// 目录中的标题
+type TocEntry struct {
+	Level int    `json:"level"`
+	Id    string `json:"id,omitempty"`
+	Text  string `json:"text"`
+}
+
+// 博客的 json 表示,受保护的博客只有元数据
+type BlogJson struct {
+	Url         string     `json:"url"`
+	Kind        string     `json:"kind"`
+	Title       string     `json:"title"`
+	KeyWords    []string   `json:"keywords,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Date        string     `json:"date,omitempty"`
+	Protected   bool       `json:"protected,omitempty"`
+	Html        string     `json:"html,omitempty"`
+	Toc         []TocEntry `json:"toc,omitempty"`
+	WordCount   int        `json:"word_count,omitempty"`
+	Links       []string   `json:"links,omitempty"`
+	Backlinks   []LinkRef  `json:"backlinks,omitempty"`
+	Related     []LinkRef  `json:"related,omitempty"`
+	Git         *GitInfo   `json:"git,omitempty"`
+	ModTime     time.Time  `json:"mtime"`
+}
+
+// 目录树中的一个节点
+type TreeNode struct {
+	Name     string      `json:"name"`
+	Url      string      `json:"url"`
+	Title    string      `json:"title"`
+	IsDir    bool        `json:"is_dir"`
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+func (item *BlogItem) KindName() string {
+	switch {
+	case item.IsDir():
+		return "dir"
+	case item.IsMd():
+		return "md"
+	}
+	return "other"
+}
+
+// 把博客转换为 json,正文是不带模板的 html 片段
+func (loader *BlogLoader) BlogJson(item *BlogItem) (*BlogJson, error) {
+	blog := &BlogJson{
+		Url:         loader.Path2Url(item.Path),
+		Kind:        item.KindName(),
+		Title:       item.Title,
+		KeyWords:    item.KeyWords,
+		Description: item.Description,
+		Date:        item.Date,
+		Protected:   item.IsProtected(),
+		ModTime:     item.ModTime,
+	}
+	if item.IsMd() && loader.Links != nil {
+		blog.Backlinks = loader.Links.Backlinks(item.Path)
+	}
+	if !item.Updated.IsZero() {
+		git := item.GitInfo
+		blog.Git = &git
+	}
+	if item.IsMd() && loader.Related != nil {
+		blog.Related = loader.Related.Related(item.Path)
+	}
+	if item.IsOther() || item.IsProtected() {
+		return blog, nil
+	}
+	fragment, err := loader.Fragment(item)
+	if err != nil {
+		return nil, err
+	}
+	blog.Html = string(fragment)
+	blog.Toc, blog.Links = HtmlOutline(fragment)
+	blog.WordCount = WordCount(string(frontMatterRe.ReplaceAll([]byte(item.File), nil)))
+	return blog, nil
+}
+
+// 渲染不带模板的 html 片段:
+// 有预先渲染的页面时取其 <body>,设置了 FragmentCommand 时使用它,否则设置了 RenderCommand 时取其输出的 <body>,否则使用 pandoc
+func (loader *BlogLoader) Fragment(item *BlogItem) ([]byte, error) {
+	loader.RLock()
+	fragmentCommand, renderCommand, templatePath := loader.FragmentCommand, loader.RenderCommand, loader.TemplatePath
+	rendered := loader.Rendered != nil
+	loader.RUnlock()
+	if rendered {
+		return HtmlBody([]byte(item.Html)), nil
+	}
+	md := []byte(item.File)
+	if fragmentCommand == "" && renderCommand != "" {
+		page, err := Md2Html(md, item.Title, templatePath, renderCommand)
+		if err != nil {
+			return nil, err
+		}
+		return HtmlBody(page), nil
+	}
+	args := []string{"pandoc", "--mathjax", "-f", "markdown", "-t", "html"}
+	if fragmentCommand != "" {
+		var err error
+		if args, err = shlex.Split(fragmentCommand); err != nil {
+			return nil, err
+		}
+	}
+	log.Println("[fragment] render command:", args)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(md)
+	return cmd.Output()
+}
+
+// 完整页面中 <body> 的内容,没有 <body> 时返回原内容
+func HtmlBody(page []byte) []byte {
+	if !bytes.Contains(bytes.ToLower(page), []byte("<body")) {
+		return page
+	}
+	doc, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return page
+	}
+	var body *html.Node
+	var find func(*html.Node)
+	find = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "body" {
+			body = n
+			return
+		}
+		for c := n.FirstChild; c != nil && body == nil; c = c.NextSibling {
+			find(c)
+		}
+	}
+	find(doc)
+	if body == nil {
+		return page
+	}
+	var buf bytes.Buffer
+	for c := body.FirstChild; c != nil; c = c.NextSibling {
+		html.Render(&buf, c)
+	}
+	return buf.Bytes()
+}
+
+// html 中的标题和链接,页内锚点不算链接
+func HtmlOutline(fragment []byte) (toc []TocEntry, links []string) {
+	doc, err := html.Parse(bytes.NewReader(fragment))
+	if err != nil {
+		return nil, nil
+	}
+	var f func(*html.Node)
+	f = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			if len(n.Data) == 2 && n.Data[0] == 'h' && n.Data[1] >= '1' && n.Data[1] <= '6' {
+				toc = append(toc, TocEntry{Level: int(n.Data[1] - '0'), Id: htmlAttr(n, "id"), Text: strings.TrimSpace(htmlText(n))})
+			} else if n.Data == "a" {
+				if href := htmlAttr(n, "href"); href != "" && !strings.HasPrefix(href, "#") {
+					links = append(links, href)
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			f(c)
+		}
+	}
+	f(doc)
+	return toc, links
+}
+
+func htmlAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func htmlText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var text strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		text.WriteString(htmlText(c))
+	}
+	return text.String()
+}
+
+// 整个博客中可见的目录树
+func BlogTree(hide, private GitIgnorer, blogRouter, blogPath string) (*TreeNode, error) {
+	return blogTree(OsFS, hide, private, blogRouter, blogPath)
+}
+
+func blogTree(fsys BlogFS, hide, private GitIgnorer, blogRouter, blogPath string) (*TreeNode, error) {
+	blogPath = SimplifyPath(blogPath)
+	root := &TreeNode{Name: "", Url: blogRouter + "/", Title: blogRouter, IsDir: true}
+	if err := buildTree(fsys, root, blogPath, hide, private, blogRouter, blogPath); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func buildTree(fsys BlogFS, node *TreeNode, path string, hide, private GitIgnorer, blogRouter, blogPath string) error {
+	dirMeta, err := loadDirMeta(fsys, path)
+	if err != nil {
+		log.Println("[tree] bad dir meta:", path, err)
+	}
+	entries, err := visibleEntries(fsys, path, dirMeta, hide, private)
+	if err != nil {
+		return err
+	}
+	items := []DirItem{}
+	for _, entry := range entries {
+		items = append(items, dirItem(fsys, SimplifyPath(path+"/"+entry.Name()), entry, hide, private, blogRouter, blogPath))
+	}
+	sortDirItems(items, dirMeta.Sort, dirMeta.Order)
+	node.Children = []*TreeNode{}
+	for _, item := range items {
+		child := &TreeNode{Name: item.Name, Url: item.Url, Title: item.Title, IsDir: item.IsDir}
+		if child.IsDir {
+			if err := buildTree(fsys, child, SimplifyPath(path+"/"+item.Name), hide, private, blogRouter, blogPath); err != nil {
+				return err
+			}
+		}
+		node.Children = append(node.Children, child)
+	}
+	return nil
+}
+
+// 使用 loader 的设置得到目录树
+func (loader *BlogLoader) Tree() (*TreeNode, error) {
+	loader.RLock()
+	defer loader.RUnlock()
+	return blogTree(orOsFS(loader.FS), loader.Hide, loader.Private, loader.BlogRouter, loader.BlogPath)
+}
@@ -0,0 +1,272 @@
+package pkg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// === archive ===
+
+// 归档页面在博客路由下的位置,博客中存在同名的文件或目录时不提供归档
+const ARCHIVE_DIR = "archive"
+
+// 归档中的一篇博客
+type ArchivePost struct {
+	Url   string    `json:"url"`
+	Title string    `json:"title"`
+	Date  time.Time `json:"date"`
+}
+
+// 一个月的博客数
+type ArchiveMonth struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Count int `json:"count"`
+}
+
+// 所有可见的博客,按日期从新到旧
+type Archive struct {
+	Posts []ArchivePost
+}
+
+// 日期优先使用 front matter 中的 date,其次是第一次提交的时间(git 不为 nil 时),最后是修改时间
+func BuildArchive(hide, private GitIgnorer, git GitMeta, blogRouter, blogPath string) (*Archive, error) {
+	return buildArchive(OsFS, hide, private, git, blogRouter, blogPath)
+}
+
+func buildArchive(fsys BlogFS, hide, private GitIgnorer, git GitMeta, blogRouter, blogPath string) (*Archive, error) {
+	blogPath = SimplifyPath(blogPath)
+	archive := &Archive{Posts: []ArchivePost{}}
+	err := WalkBlogFS(fsys, blogPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		path = SimplifyPath(path)
+		if PathMatch(path, hide, private) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !IsMdPath(path) {
+			return nil
+		}
+		content, err := fsys.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		meta, _ := MdMeta(content)
+		post := ArchivePost{Url: blogRouter + path[len(blogPath):], Title: meta.Title}
+		if post.Title == "" {
+			post.Title = strings.TrimSuffix(d.Name(), filepath.Ext(d.Name()))
+		}
+		if date, ok := meta.Time(); ok {
+			post.Date = date
+		} else if info, found := gitInfoOf(git, path); found {
+			post.Date = info.Created
+		} else if info, err := d.Info(); err == nil {
+			post.Date = info.ModTime()
+		}
+		archive.Posts = append(archive.Posts, post)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(archive.Posts, func(i, j int) bool {
+		if !archive.Posts[i].Date.Equal(archive.Posts[j].Date) {
+			return archive.Posts[i].Date.After(archive.Posts[j].Date)
+		}
+		return archive.Posts[i].Url < archive.Posts[j].Url
+	})
+	return archive, nil
+}
+
+// year 为 0 时返回所有博客,month 为 0 时返回一整年的博客
+func (archive *Archive) Filter(year, month int) []ArchivePost {
+	posts := []ArchivePost{}
+	for _, post := range archive.Posts {
+		if (year == 0 || post.Date.Year() == year) && (month == 0 || int(post.Date.Month()) == month) {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+// 每个月的博客数,从新到旧
+func (archive *Archive) Months() []ArchiveMonth {
+	months := []ArchiveMonth{}
+	for _, post := range archive.Posts {
+		year, month := post.Date.Year(), int(post.Date.Month())
+		if n := len(months); n > 0 && months[n-1].Year == year && months[n-1].Month == month {
+			months[n-1].Count++
+			continue
+		}
+		months = append(months, ArchiveMonth{Year: year, Month: month, Count: 1})
+	}
+	return months
+}
+
+func ArchiveUrl(blogRouter string, year, month int) string {
+	url := blogRouter + "/" + ARCHIVE_DIR + "/"
+	if year != 0 {
+		url += fmt.Sprintf("%04d/", year)
+	}
+	if month != 0 {
+		url += fmt.Sprintf("%02d/", month)
+	}
+	return url
+}
+
+// 解析归档页面的 url,ok 为 false 表示不是归档页面,redirect 不为空表示需要重定向到带 / 的 url
+func ParseArchiveUrl(url, blogRouter string) (year, month int, redirect string, ok bool) {
+	prefix := blogRouter + "/" + ARCHIVE_DIR
+	if url != prefix && !strings.HasPrefix(url, prefix+"/") {
+		return 0, 0, "", false
+	}
+	rest := strings.TrimPrefix(url, prefix)
+	if !strings.HasSuffix(rest, "/") {
+		redirect = url + "/"
+	}
+	parts := strings.FieldsFunc(rest, func(r rune) bool { return r == '/' })
+	if len(parts) > 2 {
+		return 0, 0, "", false
+	}
+	var err error
+	if len(parts) > 0 {
+		if year, err = strconv.Atoi(parts[0]); err != nil || fmt.Sprintf("%04d", year) != parts[0] || year < 1 {
+			return 0, 0, "", false
+		}
+	}
+	if len(parts) > 1 {
+		if month, err = strconv.Atoi(parts[1]); err != nil || fmt.Sprintf("%02d", month) != parts[1] || month < 1 || month > 12 {
+			return 0, 0, "", false
+		}
+	}
+	return year, month, redirect, true
+}
+
+// 归档页面的 md:
+// 总的归档是按年和月排列的日历,每一格是那个月的博客数;年的归档按月分组列出博客;月的归档列出博客
+func RenderArchive(archive *Archive, year, month int, blogRouter string) (title string, md []byte) {
+	var page bytes.Buffer
+	list := func(posts []ArchivePost) {
+		page.WriteString("<ul class=\"eb-archive-list\">\n")
+		for _, post := range posts {
+			page.WriteString(fmt.Sprintf("<li><span class=\"eb-archive-date\">%s</span> <a href=\"%s\">%s</a></li>\n",
+				post.Date.Format("2006-01-02"), html.EscapeString(post.Url), html.EscapeString(post.Title)))
+		}
+		page.WriteString("</ul>\n")
+	}
+	switch {
+	case year == 0:
+		title = "Archive"
+		counts := make(map[[2]int]int)
+		var years []int
+		for _, m := range archive.Months() {
+			if len(years) == 0 || years[len(years)-1] != m.Year {
+				years = append(years, m.Year)
+			}
+			counts[[2]int{m.Year, m.Month}] = m.Count
+		}
+		page.WriteString("<table class=\"eb-archive-calendar\">\n<tr><th></th>")
+		for m := 1; m <= 12; m++ {
+			page.WriteString(fmt.Sprintf("<th>%02d</th>", m))
+		}
+		page.WriteString("</tr>\n")
+		for _, y := range years {
+			page.WriteString(fmt.Sprintf("<tr><th><a href=\"%s\">%d</a></th>", ArchiveUrl(blogRouter, y, 0), y))
+			for m := 1; m <= 12; m++ {
+				if n := counts[[2]int{y, m}]; n > 0 {
+					page.WriteString(fmt.Sprintf("<td><a href=\"%s\">%d</a></td>", ArchiveUrl(blogRouter, y, m), n))
+				} else {
+					page.WriteString("<td></td>")
+				}
+			}
+			page.WriteString("</tr>\n")
+		}
+		page.WriteString("</table>\n")
+		page.WriteString(fmt.Sprintf("<p>%d posts</p>\n", len(archive.Posts)))
+	case month == 0:
+		title = fmt.Sprintf("Archive %04d", year)
+		page.WriteString(fmt.Sprintf("<p><a href=\"%s\">&laquo; all</a></p>\n", ArchiveUrl(blogRouter, 0, 0)))
+		for m := 12; m >= 1; m-- {
+			if posts := archive.Filter(year, m); len(posts) > 0 {
+				page.WriteString(fmt.Sprintf("<h2><a href=\"%s\">%04d-%02d</a></h2>\n", ArchiveUrl(blogRouter, year, m), year, m))
+				list(posts)
+			}
+		}
+	default:
+		title = fmt.Sprintf("Archive %04d-%02d", year, month)
+		page.WriteString(fmt.Sprintf("<p><a href=\"%s\">&laquo; %04d</a></p>\n", ArchiveUrl(blogRouter, year, 0), year))
+		list(archive.Filter(year, month))
+	}
+	return title, page.Bytes()
+}
+
+// 使用 loader 的设置得到归档
+func (loader *BlogLoader) Archive() (*Archive, error) {
+	loader.RLock()
+	defer loader.RUnlock()
+	return buildArchive(orOsFS(loader.FS), loader.Hide, loader.Private, loader.Git, loader.BlogRouter, loader.BlogPath)
+}
+
+// 博客中没有同名的文件或目录时提供归档页面
+func (loader *BlogLoader) HasArchive() bool {
+	loader.RLock()
+	defer loader.RUnlock()
+	if loader.FS != nil {
+		_, err := loader.FS.Stat(SimplifyPath(loader.BlogPath + "/" + ARCHIVE_DIR))
+		return errors.Is(err, fs.ErrNotExist)
+	}
+	_, err := os.Lstat(SimplifyPath(loader.BlogPath + "/" + ARCHIVE_DIR))
+	return os.IsNotExist(err)
+}
+
+// 渲染归档页面,作为目录页面对待
+func (loader *BlogLoader) LoadArchive(year, month int) (*BlogItem, error) {
+	archive, err := loader.Archive()
+	if err != nil {
+		return nil, err
+	}
+	loader.RLock()
+	blogRouter, blogPath, rendered := loader.BlogRouter, loader.BlogPath, loader.Rendered
+	loader.RUnlock()
+	url := ArchiveUrl(blogRouter, year, month)
+	// 没有博客的年和月没有归档页面
+	if year != 0 && len(archive.Filter(year, month)) == 0 {
+		return nil, fmt.Errorf("%w: no posts in %s", fs.ErrNotExist, url)
+	}
+	title, md := RenderArchive(archive, year, month, blogRouter)
+	path := SimplifyPath(blogPath + url[len(blogRouter):])
+	var page []byte
+	if rendered != nil {
+		page, err = rendered.ReadFile(RenderedPath(path, true))
+	} else {
+		page, err = loader.RenderPage(md, title)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &BlogItem{
+		Path: path,
+		Meta: Meta{Title: title},
+		Kind: BLOG_ITEM_KIND_DIR,
+		File: string(md),
+		Html: string(page),
+		ETag: ETag(page),
+		Size: int64(len(md)),
+		// 不是真实的文件,没有修改时间
+		compressed: &sync.Map{},
+	}, nil
+}
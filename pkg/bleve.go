@@ -31,7 +31,7 @@ func (bi *blogIndexerImpl) Add(blog *BlogItem) error {
 	if strings.HasPrefix(blog.Path, "/blogg/") {
 		panic("Add can not use blogg")
 	}
-	return bi.Indexer.Index(blog.Path, BlogIndex{Path: blog.Path, Meta: blog.Meta, File: blog.File})
+	return bi.Indexer.Index(blog.Path, BlogIndex{Path: blog.Path, Meta: blog.SearchableMeta(), File: blog.SearchableFile()})
 }
 
 // 删除对一个博客内容的索引
@@ -54,6 +54,15 @@ func NewBlogIndexer(indexPath string) BlogIndexer {
 	return &blogIndexerImpl{Indexer: index}
 }
 
+// 只在内存中的索引,不写入磁盘,用于 bundle
+func NewMemBlogIndexer() BlogIndexer {
+	index, err := bleve.NewMemOnly(bleve.NewIndexMapping())
+	if err != nil {
+		return nil
+	}
+	return &blogIndexerImpl{Indexer: index}
+}
+
 // 搜索博客内容
 func (bi *blogIndexerImpl) Search(keyword string, num int) ([]string, error) {
 	query := bleve.NewFuzzyQuery(keyword)
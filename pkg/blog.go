@@ -2,15 +2,21 @@ package pkg
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
-	fsutil "github.com/cncsmonster/gofsutil"
 	"github.com/easy-projects/easyblog/pkg/log"
 	"github.com/google/shlex"
 	"gopkg.in/yaml.v3"
@@ -21,7 +27,26 @@ type Meta struct {
 	Title       string   `yaml:"title"`
 	KeyWords    []string `yaml:"keywords"`
 	Description string   `yaml:"description"`
+	// 设置后博客内容会被加密,需要输入密码才能查看
+	Password string `yaml:"password"`
+	// 发布日期,如 2006-01-02,目录列表按日期排序时使用,为空时使用修改时间
+	Date string `yaml:"date"`
+	// 目录列表按权重排序时使用,越小越靠前
+	Weight int `yaml:"weight"`
 }
+
+var metaDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02", "2006/01/02"}
+
+// 解析 Date,无法解析时返回 false
+func (meta Meta) Time() (time.Time, bool) {
+	for _, layout := range metaDateLayouts {
+		if t, err := time.ParseInLocation(layout, strings.TrimSpace(meta.Date), time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
 type BlogItem struct {
 	// Path 作为唯一标识符
 	Path string
@@ -29,6 +54,18 @@ type BlogItem struct {
 	Kind int
 	File string
 	Html string
+	// 根据 Html 计算的强校验 ETag,带引号
+	ETag    string
+	ModTime time.Time
+	// 文件在磁盘上的大小
+	Size int64
+	// 内容没有读入内存,Html 为空,通过 Open 读取
+	Streamed bool
+	open     func() (io.ReadSeekCloser, error)
+	// 提交历史,BLOG_PATH 不在 git 仓库中或文件没有提交过时为空
+	GitInfo
+	// 按编码缓存的压缩后的 Html
+	compressed *sync.Map
 }
 
 // === blog loader ===
@@ -39,37 +76,104 @@ type BlogLoader struct {
 	BlogRouter    string
 	TemplatePath  string
 	RenderCommand string
-	Hide          GitIgnorer
-	Private       GitIgnorer
+	// 渲染不带模板的 html 片段的命令,用于 json api
+	FragmentCommand string
+	SymlinkPolicy   string
+	// 超过这个大小的非 md 文件不读入内存,由调用方通过 BlogItem.Open 读取
+	MaxCacheSize int64
+	// 目录列表每页的项数,0 表示不分页,可以被 _dir.yaml 覆盖
+	DirPageSize int
+	Hide        GitIgnorer
+	Private     GitIgnorer
+	// 用来解析 wiki-link 和得到反向链接,为 nil 时不处理
+	Links LinkGraph
+	// 用来推荐相关的博客,为 nil 时不推荐
+	Related RelatedIndex
+	// 用来得到创建和更新时间以及作者,为 nil 时不处理
+	Git GitMeta
+	// 读取文件的方式,为 nil 时直接读取磁盘
+	FS BlogFS
+	// 预先渲染好的页面,设置后不再调用 RenderCommand,见 eb bundle
+	Rendered BlogFS
+}
+
+// 使用同样的设置从 fsys 读取博客,url 以 blogRouter 开头;
+// 链接图,相关推荐和提交历史反映的是磁盘上的博客,不使用
+func (loader *BlogLoader) WithFS(fsys BlogFS, blogRouter string) *BlogLoader {
+	loader.RLock()
+	defer loader.RUnlock()
+	return &BlogLoader{
+		RWMutex:         &sync.RWMutex{},
+		BlogPath:        loader.BlogPath,
+		BlogRouter:      blogRouter,
+		TemplatePath:    loader.TemplatePath,
+		RenderCommand:   loader.RenderCommand,
+		FragmentCommand: loader.FragmentCommand,
+		SymlinkPolicy:   loader.SymlinkPolicy,
+		DirPageSize:     loader.DirPageSize,
+		Hide:            loader.Hide,
+		Private:         loader.Private,
+		FS:              fsys,
+	}
+}
+
+// 预先渲染的页面的路径: md 和博客中的路径相同,目录(包括归档页面)是其中的 RENDERED_DIR_PAGE
+func RenderedPath(path string, dir bool) string {
+	if dir {
+		return SimplifyPath(path + "/" + RENDERED_DIR_PAGE)
+	}
+	return SimplifyPath(path)
 }
 
 func (loader *BlogLoader) LoadBlog(path string) (*BlogItem, error) {
+	return loader.LoadBlogWith(path, DirOptions{})
+}
+
+// options 只对目录有效,用来改变目录列表的排序和页数
+func (loader *BlogLoader) LoadBlogWith(path string, options DirOptions) (*BlogItem, error) {
 	loader.RLock()
 	defer loader.RUnlock()
 	var blogRouter, blogPath, templatePath, renderCommand string = loader.BlogRouter, loader.BlogPath, loader.TemplatePath, loader.RenderCommand
 	var hide, private GitIgnorer = loader.Hide, loader.Private
+	fsys := orOsFS(loader.FS)
 	path = SimplifyPath(path)
-	if !fsutil.IsExist(path) {
-		return nil, fmt.Errorf("file not found: %s", path)
-	}
 	var blogItemType int
 	var meta Meta
+	var gitInfo GitInfo
 	var file []byte
 	var html []byte
 	var err error
-	var stat os.FileInfo
-	stat, err = os.Stat(path)
+	var stat fs.FileInfo
+	stat, err = fsys.Stat(path)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("file not found: %s", path)
 	}
 	if stat.IsDir() {
-		file, err = RenderDir(path, hide, private, blogRouter, blogPath)
+		if loader.Rendered != nil && (options.Sort != "" || options.Page > 1) {
+			// 预先渲染的目录页面只有一页,包含所有项
+			return nil, fmt.Errorf("dir options of pre-rendered page: %s", path)
+		}
+		if options.PageSize == 0 {
+			options.PageSize = loader.DirPageSize
+		}
+		file, err = renderDirPage(fsys, path, options, hide, private, blogRouter, blogPath)
 		blogItemType = BLOG_ITEM_KIND_DIR
-	} else if !strings.HasSuffix(path, ".md") && !strings.HasSuffix(path, ".markdown") {
-		file, err = os.ReadFile(path)
+	} else if !IsMdPath(path) {
 		blogItemType = BLOG_ITEM_KIND_OTHER
+		if opener, ok := fsys.(BlogFileOpener); ok && loader.MaxCacheSize > 0 && stat.Size() > loader.MaxCacheSize {
+			return &BlogItem{
+				Path:     path,
+				Kind:     blogItemType,
+				ETag:     fmt.Sprintf(`"%x-%x"`, stat.Size(), stat.ModTime().UnixNano()),
+				ModTime:  stat.ModTime(),
+				Size:     stat.Size(),
+				Streamed: true,
+				open:     func() (io.ReadSeekCloser, error) { return opener.Open(path) },
+			}, nil
+		}
+		file, err = fsys.ReadFile(path)
 	} else {
-		file, err = os.ReadFile(path)
+		file, err = fsys.ReadFile(path)
 		blogItemType = BLOG_ITEM_KIND_MD
 	}
 	if err != nil {
@@ -82,25 +186,109 @@ func (loader *BlogLoader) LoadBlog(path string) (*BlogItem, error) {
 			meta.Title = filepath.Base(path)
 			meta.Title = meta.Title[:len(meta.Title)-len(filepath.Ext(meta.Title))]
 		}
-		if html, err = Md2Html(file, meta.Title, templatePath, renderCommand); err != nil {
-			return nil, err
+		// 模板中使用的元数据
+		vars := map[string]any{}
+		if loader.Links != nil {
+			// 目录中首页的链接相对于目录本身
+			if blogItemType == BLOG_ITEM_KIND_MD {
+				file = loader.Links.ExpandWikiLinks(file, filepath.Dir(path))
+				if backlinks := loader.Links.Backlinks(path); len(backlinks) > 0 {
+					vars["backlinks"] = backlinks
+				}
+			} else {
+				file = loader.Links.ExpandWikiLinks(file, path)
+			}
+		}
+		if loader.Related != nil && blogItemType == BLOG_ITEM_KIND_MD {
+			if related := loader.Related.Related(path); len(related) > 0 {
+				vars["related"] = related
+			}
+		}
+		if info, found := gitInfoOf(loader.Git, path); found && blogItemType == BLOG_ITEM_KIND_MD {
+			gitInfo = info
+			maps.Copy(vars, GitInfoVars(info))
+		}
+		if loader.Rendered != nil {
+			// 预先渲染的页面已经加密
+			if html, err = loader.Rendered.ReadFile(RenderedPath(path, blogItemType == BLOG_ITEM_KIND_DIR)); err != nil {
+				return nil, err
+			}
+		} else {
+			if html, err = Md2HtmlWith(file, meta.Title, vars, templatePath, renderCommand); err != nil {
+				return nil, err
+			}
+			if meta.Password != "" {
+				if html, err = ProtectHtml(html, meta.Title, meta.Password); err != nil {
+					return nil, err
+				}
+			}
 		}
 	} else {
 		html = file
 	}
 	return &BlogItem{
-		Path: path,
-		Meta: meta,
-		Kind: blogItemType,
-		File: string(file),
-		Html: string(html),
+		Path:       path,
+		Meta:       meta,
+		Kind:       blogItemType,
+		File:       string(file),
+		Html:       string(html),
+		ETag:       ETag(html),
+		ModTime:    stat.ModTime(),
+		Size:       stat.Size(),
+		GitInfo:    gitInfo,
+		compressed: &sync.Map{},
 	}, nil
 }
-func (loader *BlogLoader) Url2Path(url string) string {
+
+// 通过 loader 的 FS 得到文件信息
+func (loader *BlogLoader) Stat(path string) (fs.FileInfo, error) {
 	loader.RLock()
 	defer loader.RUnlock()
-	path := loader.BlogPath + "/" + url[len(loader.BlogRouter):]
-	return SimplifyPath(path)
+	return orOsFS(loader.FS).Stat(path)
+}
+
+// 通过 loader 的 FS 读取文件
+func (loader *BlogLoader) ReadFile(path string) ([]byte, error) {
+	loader.RLock()
+	defer loader.RUnlock()
+	return orOsFS(loader.FS).ReadFile(path)
+}
+
+// 用 loader 的模板和渲染命令把生成的 md 渲染为页面,如归档和历史版本
+func (loader *BlogLoader) RenderPage(md []byte, title string) ([]byte, error) {
+	loader.RLock()
+	templatePath, renderCommand := loader.TemplatePath, loader.RenderCommand
+	loader.RUnlock()
+	return Md2Html(md, title, templatePath, renderCommand)
+}
+func (loader *BlogLoader) Url2Path(url string) (string, error) {
+	loader.RLock()
+	defer loader.RUnlock()
+	if url != loader.BlogRouter && !strings.HasPrefix(url, loader.BlogRouter+"/") {
+		return "", fmt.Errorf("url not in blog router: %s", url)
+	}
+	policy := loader.SymlinkPolicy
+	if loader.FS != nil {
+		// 不在磁盘上的文件没有符号链接,只需要检查 ..
+		policy = SYMLINK_ALLOW
+	}
+	return NewPathResolver(loader.BlogPath, policy).Resolve(url[len(loader.BlogRouter):])
+}
+
+// path 或符号链接指向的真实路径被任意一个 matcher 匹配,如链接到私有目录的路径也是私有的
+func (loader *BlogLoader) PathMatch(path string, matcher ...GitIgnorer) bool {
+	if PathMatch(path, matcher...) {
+		return true
+	}
+	loader.RLock()
+	blogPath, fsys := loader.BlogPath, loader.FS
+	loader.RUnlock()
+	// 不在磁盘上的文件没有符号链接
+	if fsys != nil {
+		return false
+	}
+	real := RealPathIn(blogPath, path)
+	return real != SimplifyPath(path) && PathMatch(real, matcher...)
 }
 func (loader *BlogLoader) Path2Url(path string) string {
 	loader.RLock()
@@ -120,11 +308,51 @@ func (item *BlogItem) IsDir() bool {
 func (item *BlogItem) IsMd() bool {
 	return (item.Kind & BLOG_ITEM_KIND_MD) != 0
 }
+func (item *BlogItem) IsOther() bool {
+	return (item.Kind & BLOG_ITEM_KIND_OTHER) != 0
+}
+
+// 从加载它的 BlogFS 中打开 Streamed 的博客
+func (item *BlogItem) Open() (io.ReadSeekCloser, error) {
+	if !item.Streamed || item.open == nil {
+		return nil, errors.New("blog is not streamed: " + item.Path)
+	}
+	return item.open()
+}
+func (item *BlogItem) IsProtected() bool {
+	return item.Meta.Password != ""
+}
+
+// 受密码保护的博客不应该暴露内容给搜索
+func (item *BlogItem) SearchableFile() string {
+	if item.IsProtected() {
+		return ""
+	}
+	return item.File
+}
+
+// 密码不能放入索引,否则搜索密码就能找到这篇博客
+func (item *BlogItem) SearchableMeta() Meta {
+	meta := item.Meta
+	meta.Password = ""
+	return meta
+}
+
+func IsMdPath(path string) bool {
+	return strings.HasSuffix(path, ".md") || strings.HasSuffix(path, ".markdown")
+}
+
+// 强校验的 ETag
+func ETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+var frontMatterRe = regexp.MustCompile(`(?s)^\s*---(.*?)---`)
 
 func MdMeta(md []byte) (meta Meta, err error) {
 	// 使用正则表达式匹配 md 中 开头的--- ---之间的内容
-	re := regexp.MustCompile(`(?s)^\s*---(.*?)---`)
-	metaBytes := re.Find(md)
+	metaBytes := frontMatterRe.Find(md)
 	if err := yaml.Unmarshal(metaBytes, &meta); err != nil {
 		return meta, err
 	}
@@ -135,6 +363,12 @@ func MdMeta(md []byte) (meta Meta, err error) {
 
 // use pandoc to convert md to html
 func Md2Html(md []byte, title string, templatePath, renderCommand string) (html []byte, err error) {
+	return Md2HtmlWith(md, title, nil, templatePath, renderCommand)
+}
+
+// vars 通过 --metadata-file 传给 pandoc,模板中可以使用,如 $for(backlinks)$;
+// 自定义的 renderCommand 和 title 一样收不到 vars,只从标准输入读取 md
+func Md2HtmlWith(md []byte, title string, vars map[string]any, templatePath, renderCommand string) (html []byte, err error) {
 	// pandoc -s --template=template.html --toc  --mathjax -f markdown -t html --metadata title="title"
 	args := []string{"pandoc", "-s", "--template=" + templatePath, "--toc", "--mathjax", "-f", "markdown", "-t", "html", "--metadata", "title=" + title}
 	if renderCommand != "" {
@@ -142,6 +376,13 @@ func Md2Html(md []byte, title string, templatePath, renderCommand string) (html
 		if err != nil {
 			return nil, err
 		}
+	} else if len(vars) > 0 {
+		metaFile, err := writeMetadataFile(vars)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(metaFile)
+		args = append(args, "--metadata-file="+metaFile)
 	}
 	log.Println("[md2html] render command:", args)
 	cmd := exec.Command(args[0], args[1:]...)
@@ -150,32 +391,20 @@ func Md2Html(md []byte, title string, templatePath, renderCommand string) (html
 	return bs, err
 }
 
-// load md
-func RenderDir(path string, hide, private GitIgnorer, blogRouter, blogPath string) (md []byte, err error) {
-	path = SimplifyPath(path)
-	log.Println("[load md] path is dir:", path)
-	items, err := os.ReadDir(path)
+// 把 vars 写入临时的 yaml 文件,调用者负责删除
+func writeMetadataFile(vars map[string]any) (string, error) {
+	bs, err := yaml.Marshal(vars)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	var dir bytes.Buffer
-	for _, item := range items {
-		name := item.Name()
-		full_path := path + "/" + name
-		full_path = SimplifyPath(full_path)
-		if PathMatch(full_path, hide, private) {
-			log.Println("[load md] path in dir ignored:", full_path)
-			continue
-		}
-		isDir := item.IsDir()
-		if isDir {
-			full_path += "/"
-			name += "/"
-		}
-		url := blogRouter + full_path[len(blogPath):]
-		name = filepath.ToSlash(name)
-		dir.WriteString(fmt.Sprintf("<a href=\"%s\">%s</a><br>", url, name))
+	file, err := os.CreateTemp("", "eb-meta-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err := file.Write(bs); err != nil {
+		os.Remove(file.Name())
+		return "", err
 	}
-	md = dir.Bytes()
-	return md, err
+	return file.Name(), nil
 }
@@ -0,0 +1,275 @@
+package pkg
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/easy-projects/easyblog/pkg/log"
+	"gopkg.in/yaml.v3"
+)
+
+// === bundle ===
+
+// bundle 是在 eb 可执行文件后面追加的 zip,其中的内容:
+const (
+	// 运行 bundle 时使用的配置
+	BUNDLE_CONFIG = "eb.toml"
+	// 博客中可见的文件
+	BUNDLE_BLOG_DIR = "blog"
+	// 预先渲染好的页面,路径见 RenderedPath
+	BUNDLE_HTML_DIR = "html"
+	// 预先提取的搜索内容,启动时直接加入内存中的索引
+	BUNDLE_INDEX = "index.json"
+)
+
+// 运行 bundle 时 BLOG_PATH 的值,不需要在磁盘上存在
+const BUNDLE_BLOG_PATH = "/eb-bundle/blog"
+
+// 目录和归档页面渲染后的文件名
+const RENDERED_DIR_PAGE = "index.html"
+
+// bundle 中受密码保护的博客只保留 front matter,password 换成这个值,只用来标记博客受保护;
+// 页面在打包时已经加密,运行时不需要密码
+const BUNDLE_PASSWORD = "*"
+
+// 编译进程序的博客的渲染结果和搜索内容,和 EmbeddedBlog 一起由 bundle 设置
+var (
+	EmbeddedRendered fs.FS
+	EmbeddedIndex    []BlogIndex
+)
+
+type Bundle struct {
+	Config   *Config
+	Blog     fs.FS
+	Rendered fs.FS
+	Index    []BlogIndex
+}
+
+// 读取追加在 exe 后面的 bundle,exe 不是 bundle 时返回 nil
+func OpenBundle(exe string) (*Bundle, error) {
+	file, err := os.Open(exe)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	reader, err := zip.NewReader(file, stat.Size())
+	if err != nil {
+		file.Close()
+		return nil, nil
+	}
+	data, err := fs.ReadFile(reader, BUNDLE_CONFIG)
+	if err != nil {
+		file.Close()
+		return nil, nil
+	}
+	// 文件在程序退出前一直打开
+	bundle := &Bundle{Config: ParseConfig(data)}
+	if bundle.Blog, err = fs.Sub(reader, BUNDLE_BLOG_DIR); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if bundle.Rendered, err = fs.Sub(reader, BUNDLE_HTML_DIR); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if data, err = fs.ReadFile(reader, BUNDLE_INDEX); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &bundle.Index); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// 使用 bundle 中的博客,之后应该使用 bundle.Config 启动服务器
+func (bundle *Bundle) Use() {
+	EmbeddedBlog = bundle.Blog
+	EmbeddedRendered = bundle.Rendered
+	EmbeddedIndex = bundle.Index
+}
+
+// 把 exe 复制到 output,在后面追加 loader 读取和渲染的博客;隐藏和私有的博客不会放入 bundle
+func WriteBundle(output, exe string, loader *BlogLoader, config *Config) error {
+	if bundle, _ := OpenBundle(exe); bundle != nil {
+		return fmt.Errorf("%s is already a bundle", exe)
+	}
+	bin, err := os.ReadFile(exe)
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := out.Write(bin); err != nil {
+		return err
+	}
+	zw := zip.NewWriter(out)
+	zw.SetOffset(int64(len(bin)))
+	if err := writeBundle(zw, loader, config); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+func writeBundle(zw *zip.Writer, loader *BlogLoader, config *Config) error {
+	loader.RLock()
+	fsys, blogPath, hide, private := orOsFS(loader.FS), loader.BlogPath, loader.Hide, loader.Private
+	loader.RUnlock()
+	write := func(name string, info fs.FileInfo, content []byte) error {
+		header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+		if info != nil {
+			header.Modified = info.ModTime()
+		}
+		w, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	}
+	index := []BlogIndex{}
+	files := 0
+	err := WalkBlogFS(fsys, blogPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if PathMatch(path, hide, private) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel := path[len(blogPath):]
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// 运行时不会再渲染,页面中包含所有项,不分页
+			item, err := loader.LoadBlogWith(path, DirOptions{All: true})
+			if err != nil {
+				return err
+			}
+			return write(BUNDLE_HTML_DIR+RenderedPath(rel, true), info, []byte(item.Html))
+		}
+		content, err := fsys.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var item *BlogItem
+		if IsMdPath(path) {
+			if item, err = loader.LoadBlog(path); err != nil {
+				return err
+			}
+			// 受保护博客的原文和密码不能放入 bundle
+			if item.IsProtected() {
+				if content, err = protectedStub(item.Meta); err != nil {
+					return err
+				}
+			}
+		}
+		if err := write(BUNDLE_BLOG_DIR+rel, info, content); err != nil {
+			return err
+		}
+		files++
+		if item == nil {
+			return nil
+		}
+		index = append(index, BlogIndex{Path: BUNDLE_BLOG_PATH + rel, Meta: item.SearchableMeta(), File: item.SearchableFile()})
+		return write(BUNDLE_HTML_DIR+RenderedPath(rel, false), info, []byte(item.Html))
+	})
+	if err != nil {
+		return err
+	}
+	if loader.HasArchive() {
+		archive, err := loader.Archive()
+		if err != nil {
+			return err
+		}
+		pages := [][2]int{{0, 0}}
+		for _, month := range archive.Months() {
+			if pages[len(pages)-1][0] != month.Year {
+				pages = append(pages, [2]int{month.Year, 0})
+			}
+			pages = append(pages, [2]int{month.Year, month.Month})
+		}
+		for _, page := range pages {
+			item, err := loader.LoadArchive(page[0], page[1])
+			if err != nil {
+				return err
+			}
+			if err := write(BUNDLE_HTML_DIR+RenderedPath(ArchiveUrl("", page[0], page[1]), true), nil, []byte(item.Html)); err != nil {
+				return err
+			}
+		}
+	}
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err := write(BUNDLE_INDEX, nil, data); err != nil {
+		return err
+	}
+	if data, err = bundleConfig(config); err != nil {
+		return err
+	}
+	log.Println("[bundle] write:", files, "files,", len(index), "blogs")
+	return write(BUNDLE_CONFIG, nil, data)
+}
+
+// 只有 front matter 的 md,password 为 BUNDLE_PASSWORD
+func protectedStub(meta Meta) ([]byte, error) {
+	meta.Password = BUNDLE_PASSWORD
+	bs, err := yaml.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte("---\n"), bs...), "---\n"...), nil
+}
+
+// 运行 bundle 时的配置: 只保留和展示相关的设置,不需要磁盘上的文件,不需要 pandoc
+func bundleConfig(config *Config) ([]byte, error) {
+	config.RLock()
+	bundle := &Config{
+		PORT:                config.PORT,
+		BLOG_ROUTER:         config.BLOG_ROUTER,
+		API_ROUTER:          config.API_ROUTER,
+		BLOG_PATH:           BUNDLE_BLOG_PATH,
+		BLOG_FS:             BLOG_FS_EMBED,
+		NOT_GEN:             true,
+		SEARCH_NUM:          config.SEARCH_NUM,
+		CACHE_CONTROL_DIR:   config.CACHE_CONTROL_DIR,
+		CACHE_CONTROL_MD:    config.CACHE_CONTROL_MD,
+		CACHE_CONTROL_ASSET: config.CACHE_CONTROL_ASSET,
+		DIR_PAGE_SIZE:       config.DIR_PAGE_SIZE,
+		NO_COMPRESS:         config.NO_COMPRESS,
+		RELATED_NUM:         config.RELATED_NUM,
+		GRAPH_PAGE:          config.GRAPH_PAGE,
+		RATE_LIMITE_SECOND:  config.RATE_LIMITE_SECOND,
+		RATE_LIMITE_MINUTE:  config.RATE_LIMITE_MINUTE,
+		RATE_LIMITE_HOUR:    config.RATE_LIMITE_HOUR,
+	}
+	config.RUnlock()
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(bundle); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,408 @@
+package pkg
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+	"golang.org/x/net/html"
+)
+
+// ===== link checker =====
+
+const (
+	// 链接指向的文件不存在或不可见
+	LINK_BROKEN = "broken"
+	// 链接中的锚点在目标页面中不存在
+	LINK_ANCHOR = "anchor"
+	// 公开的页面链接到了私有的页面
+	LINK_PRIVATE = "private"
+	// 没有被其他页面链接的博客
+	LINK_ORPHAN = "orphan"
+	// 外部链接无法访问
+	LINK_EXTERNAL = "external"
+)
+
+// 同时探测外部链接的数量
+const CHECK_WORKERS = 8
+
+// 自动生成的链接不需要检查,也不算作页面之间的链接
+var generatedLinkClasses = []string{"eb-dir-listing", "eb-dir-pages", "eb-backlinks", "eb-related"}
+
+type LinkIssue struct {
+	Kind string `json:"kind"`
+	// 出现问题的页面的 url
+	Page   string `json:"page"`
+	Link   string `json:"link,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func (issue LinkIssue) String() string {
+	s := issue.Kind + "\t" + issue.Page
+	if issue.Link != "" {
+		s += "\t" + issue.Link
+	}
+	if issue.Detail != "" {
+		s += "\t(" + issue.Detail + ")"
+	}
+	return s
+}
+
+type LinkChecker interface {
+	// 检查整个博客,包括孤立的博客
+	Check() ([]LinkIssue, error)
+	// 检查一个渲染后的页面中的链接,path 是页面对应的文件或目录
+	CheckPage(path string, page []byte) []LinkIssue
+}
+
+// client 为 nil 时不探测外部链接
+func NewLinkChecker(loader *BlogLoader, client *http.Client, userAgent string) LinkChecker {
+	return &linkCheckerImpl{
+		loader:    loader,
+		client:    client,
+		userAgent: userAgent,
+		ids:       make(map[string]pageIds),
+		probes:    make(map[string]string),
+	}
+}
+
+type pageIds struct {
+	modTime time.Time
+	ids     map[string]bool
+}
+
+type linkCheckerImpl struct {
+	loader    *BlogLoader
+	client    *http.Client
+	userAgent string
+	mux       sync.Mutex
+	// 按路径缓存页面中的 id,文件修改后失效
+	ids map[string]pageIds
+	// 外部链接的探测结果,空字符串表示可以访问
+	probes map[string]string
+}
+
+// 页面中的一个链接
+type pageLink struct {
+	href string
+	// 链接指向的博客中的文件,外部链接为空
+	target string
+	// 外部链接的完整 url
+	external string
+}
+
+func (c *linkCheckerImpl) Check() ([]LinkIssue, error) {
+	loader := c.loader
+	loader.RLock()
+	fsys, blogPath, hide, private := orOsFS(loader.FS), SimplifyPath(loader.BlogPath), loader.Hide, loader.Private
+	loader.RUnlock()
+	var pages []string
+	err := WalkBlogFS(fsys, blogPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		path = SimplifyPath(path)
+		if PathMatch(path, hide) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || IsMdPath(path) {
+			pages = append(pages, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var issues []LinkIssue
+	externals := make(map[string][]LinkIssue)
+	linked := make(map[string]bool)
+	for _, path := range pages {
+		item, err := loader.LoadBlog(path)
+		if err != nil {
+			issues = append(issues, LinkIssue{Kind: LINK_BROKEN, Page: loader.Path2Url(path), Detail: "render failed: " + err.Error()})
+			continue
+		}
+		page, err := c.plainHtml(item)
+		if err != nil {
+			issues = append(issues, LinkIssue{Kind: LINK_BROKEN, Page: loader.Path2Url(path), Detail: err.Error()})
+			continue
+		}
+		pageIssues, links := c.checkPage(path, page)
+		issues = append(issues, pageIssues...)
+		for _, link := range links {
+			if link.external != "" {
+				externals[link.external] = append(externals[link.external], LinkIssue{Kind: LINK_EXTERNAL, Page: loader.Path2Url(path), Link: link.href})
+			} else if link.target != path && !PathMatch(path, private) {
+				// 读者看不到私有页面中的链接
+				linked[link.target] = true
+				// 链接到目录相当于链接到目录的首页
+				if index := DirIndex(link.target); index != "" {
+					linked[index] = true
+				}
+			}
+		}
+	}
+	issues = append(issues, c.probeAll(externals)...)
+	for _, path := range pages {
+		if IsOrphan(path, linked[path], private) {
+			issues = append(issues, LinkIssue{Kind: LINK_ORPHAN, Page: loader.Path2Url(path)})
+		}
+	}
+	return issues, nil
+}
+
+func (c *linkCheckerImpl) CheckPage(path string, page []byte) []LinkIssue {
+	path = SimplifyPath(path)
+	issues, links := c.checkPage(path, page)
+	if c.client == nil {
+		return issues
+	}
+	for _, link := range links {
+		if link.external == "" {
+			continue
+		}
+		if detail := c.probe(link.external); detail != "" {
+			issues = append(issues, LinkIssue{Kind: LINK_EXTERNAL, Page: c.loader.Path2Url(path), Link: link.href, Detail: detail})
+		}
+	}
+	return issues
+}
+
+// 检查页面中指向博客内部的链接,返回发现的问题和页面中所有需要关注的链接
+func (c *linkCheckerImpl) checkPage(path string, page []byte) (issues []LinkIssue, links []pageLink) {
+	loader := c.loader
+	loader.RLock()
+	blogRouter, hide, private := loader.BlogRouter, loader.Hide, loader.Private
+	loader.RUnlock()
+	pageUrl := loader.Path2Url(path)
+	base := &url.URL{Path: pageUrl}
+	if stat, err := loader.Stat(path); err == nil && stat.IsDir() {
+		base.Path = strings.TrimSuffix(pageUrl, "/") + "/"
+	}
+	isPrivate := PathMatch(path, private)
+	hrefs, ids := pageRefs(page)
+	issue := func(kind, href, detail string) {
+		issues = append(issues, LinkIssue{Kind: kind, Page: pageUrl, Link: href, Detail: detail})
+	}
+	for _, href := range hrefs {
+		u, err := url.Parse(strings.TrimSpace(href))
+		if err != nil {
+			issue(LINK_BROKEN, href, "bad url")
+			continue
+		}
+		switch {
+		case u.Scheme == "http" || u.Scheme == "https":
+			links = append(links, pageLink{href: href, external: u.String()})
+			continue
+		case u.Scheme == "" && u.Host != "":
+			u.Scheme = "https"
+			links = append(links, pageLink{href: href, external: u.String()})
+			continue
+		case u.Scheme != "":
+			// mailto: javascript: data: 等
+			continue
+		case u.Path == "":
+			if u.Fragment != "" && !ids[u.Fragment] {
+				issue(LINK_ANCHOR, href, "no such anchor in this page")
+			}
+			continue
+		}
+		ref := base.ResolveReference(u)
+		if ref.Path != blogRouter && !strings.HasPrefix(ref.Path, blogRouter+"/") {
+			// 不属于博客的路由,如 api
+			continue
+		}
+		target, err := loader.Url2Path(ref.Path)
+		if err != nil {
+			issue(LINK_BROKEN, href, err.Error())
+			continue
+		}
+		stat, err := loader.Stat(target)
+		if err != nil {
+			issue(LINK_BROKEN, href, "not found")
+			continue
+		}
+		if PathMatch(target, hide) {
+			issue(LINK_BROKEN, href, "hidden")
+			continue
+		}
+		links = append(links, pageLink{href: href, target: target})
+		if !isPrivate && PathMatch(target, private) {
+			issue(LINK_PRIVATE, href, "public page links to a private page")
+		}
+		if u.Fragment != "" && (stat.IsDir() || IsMdPath(target)) {
+			targetIds := ids
+			if target != path {
+				if targetIds, err = c.pageIds(target, stat.ModTime()); err != nil {
+					issue(LINK_BROKEN, href, "render failed: "+err.Error())
+					continue
+				}
+			}
+			if !targetIds[u.Fragment] {
+				issue(LINK_ANCHOR, href, "no such anchor")
+			}
+		}
+	}
+	return issues, links
+}
+
+// 渲染目标页面得到其中的 id
+func (c *linkCheckerImpl) pageIds(path string, modTime time.Time) (map[string]bool, error) {
+	c.mux.Lock()
+	cached, found := c.ids[path]
+	c.mux.Unlock()
+	if found && cached.modTime.Equal(modTime) {
+		return cached.ids, nil
+	}
+	item, err := c.loader.LoadBlog(path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := c.plainHtml(item)
+	if err != nil {
+		return nil, err
+	}
+	_, ids := pageRefs(page)
+	c.mux.Lock()
+	c.ids[path] = pageIds{modTime: modTime, ids: ids}
+	c.mux.Unlock()
+	return ids, nil
+}
+
+// 受密码保护的页面需要先解密
+func (c *linkCheckerImpl) plainHtml(item *BlogItem) ([]byte, error) {
+	if item.IsProtected() {
+		return UnprotectHtml([]byte(item.Html), item.Password)
+	}
+	return []byte(item.Html), nil
+}
+
+// 页面中的链接(a 的 href 和 img 的 src)和所有的 id,自动生成的部分被跳过
+func pageRefs(page []byte) (hrefs []string, ids map[string]bool) {
+	ids = make(map[string]bool)
+	doc, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return nil, ids
+	}
+	var f func(*html.Node, bool)
+	f = func(n *html.Node, generated bool) {
+		if n.Type == html.ElementNode {
+			if id := htmlAttr(n, "id"); id != "" {
+				ids[id] = true
+			}
+			if n.Data == "a" {
+				if name := htmlAttr(n, "name"); name != "" {
+					ids[name] = true
+				}
+			}
+			classes := strings.Fields(htmlAttr(n, "class"))
+			generated = generated || slices.ContainsFunc(classes, func(class string) bool {
+				return slices.Contains(generatedLinkClasses, class)
+			})
+			if !generated {
+				if n.Data == "a" && htmlAttr(n, "href") != "" {
+					hrefs = append(hrefs, htmlAttr(n, "href"))
+				} else if n.Data == "img" && htmlAttr(n, "src") != "" {
+					hrefs = append(hrefs, htmlAttr(n, "src"))
+				}
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			f(child, generated)
+		}
+	}
+	f(doc, false)
+	return hrefs, ids
+}
+
+// 并发探测所有外部链接,refs 是每个链接出现的位置
+func (c *linkCheckerImpl) probeAll(refs map[string][]LinkIssue) []LinkIssue {
+	if c.client == nil || len(refs) == 0 {
+		return nil
+	}
+	urls := make([]string, 0, len(refs))
+	for u := range refs {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	jobs := make(chan string)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < min(CHECK_WORKERS, len(urls)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range jobs {
+				c.probe(u)
+			}
+		}()
+	}
+	for _, u := range urls {
+		jobs <- u
+	}
+	close(jobs)
+	wg.Wait()
+	var issues []LinkIssue
+	for _, u := range urls {
+		detail := c.probe(u)
+		if detail == "" {
+			continue
+		}
+		for _, issue := range refs[u] {
+			issue.Detail = detail
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// 探测外部链接,可以访问时返回空字符串;HEAD 失败时再尝试 GET
+func (c *linkCheckerImpl) probe(u string) string {
+	c.mux.Lock()
+	detail, found := c.probes[u]
+	c.mux.Unlock()
+	if found {
+		return detail
+	}
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		detail = c.request(method, u)
+		if detail == "" {
+			break
+		}
+	}
+	log.Println("[check] probe:", u, detail)
+	c.mux.Lock()
+	c.probes[u] = detail
+	c.mux.Unlock()
+	return detail
+}
+
+func (c *linkCheckerImpl) request(method, u string) string {
+	req, err := http.NewRequest(method, u, nil)
+	if err != nil {
+		return err.Error()
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err.Error()
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Sprintf("status %d", resp.StatusCode)
+	}
+	return ""
+}
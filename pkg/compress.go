@@ -0,0 +1,109 @@
+package pkg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// === compress ===
+
+const (
+	ENCODING_GZIP   = "gzip"
+	ENCODING_BROTLI = "br"
+)
+
+// 小于这个大小的内容压缩后收益不大
+const COMPRESS_MIN_SIZE = 1024
+
+// 预压缩文件的后缀
+var EncodingExts = map[string]string{
+	ENCODING_GZIP:   ".gz",
+	ENCODING_BROTLI: ".br",
+}
+
+// 只有文本类型值得压缩
+func Compressible(contentType string) bool {
+	contentType = strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch {
+	case strings.HasPrefix(contentType, "text/") && contentType != "text/event-stream":
+		return true
+	case contentType == "application/json", contentType == "application/javascript",
+		contentType == "application/xml", contentType == "image/svg+xml", contentType == "application/wasm":
+		return true
+	}
+	return strings.HasSuffix(contentType, "+json") || strings.HasSuffix(contentType, "+xml")
+}
+
+// 根据 Accept-Encoding 选择编码,同样的权重下 br 优先,不支持时返回空字符串
+func NegotiateEncoding(acceptEncoding string) string {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, param := range fields[1:] {
+			if v, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if name == "*" {
+			name = ENCODING_BROTLI
+		}
+		if _, supported := EncodingExts[name]; !supported || q <= 0 {
+			continue
+		}
+		if q > bestQ || (q == bestQ && name == ENCODING_BROTLI) {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+func NewCompressWriter(w io.Writer, encoding string) (io.WriteCloser, error) {
+	switch encoding {
+	case ENCODING_GZIP:
+		return gzip.NewWriterLevel(w, gzip.BestCompression)
+	case ENCODING_BROTLI:
+		return brotli.NewWriterLevel(w, brotli.DefaultCompression), nil
+	}
+	return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+}
+
+func Compress(data []byte, encoding string) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := NewCompressWriter(&buf, encoding)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 压缩后的 Html,每种编码只计算一次;不是由 LoadBlog 得到的博客每次都重新压缩
+func (item *BlogItem) Compressed(encoding string) ([]byte, error) {
+	if item.compressed == nil {
+		return Compress([]byte(item.Html), encoding)
+	}
+	if data, found := item.compressed.Load(encoding); found {
+		return data.([]byte), nil
+	}
+	data, err := Compress([]byte(item.Html), encoding)
+	if err != nil {
+		return nil, err
+	}
+	item.compressed.Store(encoding, data)
+	return data, nil
+}
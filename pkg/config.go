@@ -2,6 +2,7 @@ package pkg
 
 import (
 	"sync"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	fsutil "github.com/cncsmonster/gofsutil"
@@ -10,21 +11,67 @@ import (
 
 // ====== config =====
 
+// HISTORY 和 PREVIEW 的取值,为空时不提供
+const (
+	ACCESS_PUBLIC = "public"
+	ACCESS_ADMIN  = "admin"
+)
+
 type Config struct {
-	sync.RWMutex   `yaml:"-" toml:"-" json:"-"`
-	PORT           int
-	BLOG_ROUTER    string
-	API_ROUTER     string
-	BLOG_PATH      string
-	GEN_PATH       string
-	NOT_GEN        bool
-	HIDE_PATHS     []string
-	PRIVATE_PATHS  []string
-	TEMPLATE_PATH  string
+	sync.RWMutex `yaml:"-" toml:"-" json:"-"`
+	PORT         int
+	BLOG_ROUTER  string
+	API_ROUTER   string
+	BLOG_PATH    string
+	// where blog files are read from: os(default) reads BLOG_PATH, zip reads BLOG_ZIP,
+	// embed reads the blog compiled into the binary; BLOG_PATH is still the root of paths
+	BLOG_FS       string
+	BLOG_ZIP      string
+	GEN_PATH      string
+	NOT_GEN       bool
+	HIDE_PATHS    []string
+	PRIVATE_PATHS []string
+	// also use .gitignore files in BLOG_PATH as hide rules
+	HONOR_GITIGNORE bool
+	TEMPLATE_PATH   string
+	// how to treat symlinks when url becomes path: within(default), deny, allow
+	SYMLINK_POLICY string
 	APP_DATA_PATH  string
 	SEARCH_NUM     int
 	SEARCH_PLUGINS []SearcherPlugin
 	RENDER_COMMAND string
+	// command to render md to html fragment without template, for json api;
+	// when empty, pandoc is used, or the <body> of RENDER_COMMAND output if RENDER_COMMAND is set
+	FRAGMENT_COMMAND string
+	// Cache-Control header for dir listings, markdown pages and other files
+	CACHE_CONTROL_DIR   string
+	CACHE_CONTROL_MD    string
+	CACHE_CONTROL_ASSET string
+	// files larger than this(in bytes) are streamed from disk and never cached, default 1MB
+	MAX_CACHE_SIZE int64
+	// items per page of dir listings, 0 means no pagination, _dir.yaml can override it
+	DIR_PAGE_SIZE int
+	// disable response compression negotiated by Accept-Encoding
+	NO_COMPRESS bool
+	// also write precompressed files next to generated files, "gzip" and/or "br"
+	GEN_COMPRESS []string
+	// log broken links, anchors and private links of each generated page
+	CHECK_LINKS bool
+	// http client for probing external links in `eb check links -external`
+	CHECK_TIMEOUT    string
+	CHECK_USER_AGENT string
+	// number of related posts shown at the end of a post, 0 (default) disables it
+	RELATED_NUM int
+	// who can see ?history and ?diff of posts: "" (disabled), "public" or "admin"
+	HISTORY string
+	// who can see the blog at a git branch, tag or commit under /blog@<ref>/: "" (disabled), "public" or "admin"
+	PREVIEW string
+	// serve a page visualising the link graph at /graph
+	GRAPH_PAGE bool
+	// dev mode, reload the page in browser when the blog changes
+	LIVE_RELOAD bool
+	// token for admin api, admin api is disabled when empty
+	ADMIN_TOKEN string
 
 	// for visit limit
 	RATE_LIMITE_SECOND int
@@ -45,6 +92,20 @@ func LoadConfig(file string) *Config {
 	// if err := yaml.Unmarshal(data, &config); err != nil {
 	// 	log.Fatal(err)
 	// }
+	return setupConfig(&config)
+}
+
+// 解析 bundle 中的配置
+func ParseConfig(data []byte) *Config {
+	var config Config
+	if _, err := toml.Decode(string(data), &config); err != nil {
+		log.Fatal("[config] toml decode error:", err)
+	}
+	return setupConfig(&config)
+}
+
+// 设置默认值并检查配置
+func setupConfig(config *Config) *Config {
 	config.BLOG_PATH = SimplifyPath(config.BLOG_PATH)
 	config.GEN_PATH = SimplifyPath(config.GEN_PATH)
 	if config.BLOG_ROUTER == "" {
@@ -53,6 +114,24 @@ func LoadConfig(file string) *Config {
 	if config.API_ROUTER == "" {
 		config.API_ROUTER = "/api"
 	}
+	if config.CACHE_CONTROL_DIR == "" {
+		config.CACHE_CONTROL_DIR = "no-cache"
+	}
+	if config.CACHE_CONTROL_MD == "" {
+		config.CACHE_CONTROL_MD = "no-cache"
+	}
+	if config.CACHE_CONTROL_ASSET == "" {
+		config.CACHE_CONTROL_ASSET = "public, max-age=3600"
+	}
+	if config.MAX_CACHE_SIZE == 0 {
+		config.MAX_CACHE_SIZE = 1 << 20
+	}
+	if config.CHECK_TIMEOUT == "" {
+		config.CHECK_TIMEOUT = "10s"
+	}
+	if _, err := time.ParseDuration(config.CHECK_TIMEOUT); err != nil {
+		log.Fatal("[config] bad check_timeout:", err)
+	}
 	if config.SEARCH_NUM == 0 {
 		config.SEARCH_NUM = 12
 	}
@@ -65,8 +144,34 @@ func LoadConfig(file string) *Config {
 	if config.RATE_LIMITE_HOUR == 0 {
 		config.RATE_LIMITE_HOUR = 1000
 	}
-	if !fsutil.IsExist(config.BLOG_PATH) {
-		log.Fatal("[config] blog path not exist")
+	if config.HISTORY != "" && config.HISTORY != ACCESS_PUBLIC && config.HISTORY != ACCESS_ADMIN {
+		log.Fatal("[config] history must be public or admin:", config.HISTORY)
+	}
+	if config.PREVIEW != "" && config.PREVIEW != ACCESS_PUBLIC && config.PREVIEW != ACCESS_ADMIN {
+		log.Fatal("[config] preview must be public or admin:", config.PREVIEW)
+	}
+	for _, encoding := range config.GEN_COMPRESS {
+		if _, supported := EncodingExts[encoding]; !supported {
+			log.Fatal("[config] unsupported gen_compress encoding:", encoding)
+		}
+	}
+	switch config.BLOG_FS {
+	case "", BLOG_FS_OS:
+		config.BLOG_FS = BLOG_FS_OS
+		if !fsutil.IsExist(config.BLOG_PATH) {
+			log.Fatal("[config] blog path not exist")
+		}
+	case BLOG_FS_ZIP:
+		if !fsutil.IsExist(config.BLOG_ZIP) {
+			log.Fatal("[config] blog zip not exist")
+		}
+	case BLOG_FS_EMBED:
+	default:
+		log.Fatal("[config] blog_fs must be os, zip or embed:", config.BLOG_FS)
+	}
+	// bundle 不使用磁盘上的数据和模板,页面已经渲染好
+	if config.BLOG_FS == BLOG_FS_EMBED {
+		return config
 	}
 	if !fsutil.IsExist(config.APP_DATA_PATH) {
 		log.Println("[config] app data path not exist, create it")
@@ -74,5 +179,5 @@ func LoadConfig(file string) *Config {
 	if !fsutil.IsExist(config.TEMPLATE_PATH) {
 		log.Println("[config] template path not exist, create it")
 	}
-	return &config
+	return config
 }
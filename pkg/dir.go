@@ -0,0 +1,429 @@
+package pkg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"math"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+	"gopkg.in/yaml.v3"
+)
+
+// === dir ===
+
+// 目录的元数据文件
+const DIR_META_FILE = "_dir.yaml"
+
+// 目录中存在这些文件时,按顺序取第一个作为目录的首页,目录列表附加在后面
+var DIR_INDEX_FILES = []string{"index.md", "README.md", "readme.md"}
+
+const (
+	DIR_SORT_NAME   = "name"
+	DIR_SORT_DATE   = "date"
+	DIR_SORT_WEIGHT = "weight"
+)
+
+// 每分钟阅读的字数
+const READING_SPEED = 300
+
+// _dir.yaml 的内容
+type DirMeta struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	// name, date, weight,默认为 name; 前缀 - 表示倒序
+	Sort string `yaml:"sort"`
+	// 排在最前面的子项,按给出的顺序,其余的按 Sort 排序
+	Order []string `yaml:"order"`
+	// 不在列表中显示的子项,支持通配符,仍然可以访问
+	Hidden []string `yaml:"hidden"`
+	// 每页的项数,0 表示使用全局的设置
+	PageSize int `yaml:"page_size"`
+}
+
+// 渲染目录列表时的选项,来自请求的 query string 和全局设置
+type DirOptions struct {
+	// 为空时使用 _dir.yaml 中的设置
+	Sort string
+	// 从 1 开始,0 视为 1
+	Page int
+	// _dir.yaml 中没有设置时使用,0 表示不分页
+	PageSize int
+	// 列出所有项,忽略分页的设置;静态页面无法使用 ?page=,生成时使用
+	All bool
+}
+
+// 目录列表中的一项
+type DirItem struct {
+	Name        string    `json:"name"`
+	Url         string    `json:"url"`
+	IsDir       bool      `json:"is_dir"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Date        time.Time `json:"date"`
+	Weight      int       `json:"weight,omitempty"`
+	// 阅读时间,单位为分钟,只有 md 有
+	ReadingTime int `json:"reading_time,omitempty"`
+	// 子目录中可见的项数
+	Count int `json:"count,omitempty"`
+}
+
+// 一页目录列表
+type DirListing struct {
+	Items []DirItem `json:"items"`
+	Sort  string    `json:"sort"`
+	Page  int       `json:"page"`
+	Pages int       `json:"pages"`
+	Total int       `json:"total"`
+}
+
+func ValidDirSort(sort string) bool {
+	by := strings.TrimPrefix(sort, "-")
+	return by == "" || by == DIR_SORT_NAME || by == DIR_SORT_DATE || by == DIR_SORT_WEIGHT
+}
+
+// 读取目录的 _dir.yaml,不存在时返回空的元数据
+func LoadDirMeta(dir string) (meta DirMeta, err error) {
+	return loadDirMeta(OsFS, dir)
+}
+
+func loadDirMeta(fsys BlogFS, dir string) (meta DirMeta, err error) {
+	bs, err := fsys.ReadFile(SimplifyPath(dir + "/" + DIR_META_FILE))
+	if errors.Is(err, fs.ErrNotExist) {
+		return meta, nil
+	} else if err != nil {
+		return meta, err
+	}
+	if err := yaml.Unmarshal(bs, &meta); err != nil {
+		return meta, fmt.Errorf("bad %s in %s: %w", DIR_META_FILE, dir, err)
+	}
+	if !ValidDirSort(meta.Sort) {
+		return meta, fmt.Errorf("bad sort in %s: %s", dir, meta.Sort)
+	}
+	return meta, nil
+}
+
+// 目录的首页,没有时返回空字符串
+func DirIndex(dir string) string {
+	return dirIndex(OsFS, dir)
+}
+
+func dirIndex(fsys BlogFS, dir string) string {
+	for _, name := range DIR_INDEX_FILES {
+		path := SimplifyPath(dir + "/" + name)
+		if stat, err := fsys.Stat(path); err == nil && !stat.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// 统计字数,中日韩文字每个字算一个词
+func WordCount(text string) int {
+	count := 0
+	inWord := false
+	for _, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			count++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				count++
+			}
+			inWord = true
+		default:
+			inWord = false
+		}
+	}
+	return count
+}
+
+// 阅读时间,单位为分钟,至少为 1
+func ReadingTime(words int) int {
+	return max(1, int(math.Ceil(float64(words)/READING_SPEED)))
+}
+
+// 目录渲染为 md: 首页(或 _dir.yaml 中的描述)加上目录列表,元数据放在开头的 front matter 中
+func RenderDir(path string, hide, private GitIgnorer, blogRouter, blogPath string) (md []byte, err error) {
+	return RenderDirPage(path, DirOptions{}, hide, private, blogRouter, blogPath)
+}
+
+// 按 options 排序和分页后渲染目录
+func RenderDirPage(path string, options DirOptions, hide, private GitIgnorer, blogRouter, blogPath string) (md []byte, err error) {
+	return renderDirPage(OsFS, path, options, hide, private, blogRouter, blogPath)
+}
+
+func renderDirPage(fsys BlogFS, path string, options DirOptions, hide, private GitIgnorer, blogRouter, blogPath string) (md []byte, err error) {
+	path = SimplifyPath(path)
+	log.Println("[load md] path is dir:", path)
+	dirMeta, err := loadDirMeta(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	var meta Meta
+	var body []byte
+	index := dirIndex(fsys, path)
+	if index != "" && !PathMatch(index, hide, private) {
+		content, err := fsys.ReadFile(index)
+		if err != nil {
+			return nil, err
+		}
+		if meta, err = MdMeta(content); err != nil {
+			return nil, err
+		}
+		body = frontMatterRe.ReplaceAll(content, nil)
+	} else {
+		index = ""
+	}
+	if dirMeta.Title != "" {
+		meta.Title = dirMeta.Title
+	}
+	if dirMeta.Description != "" {
+		meta.Description = dirMeta.Description
+	}
+	var dir bytes.Buffer
+	if meta.Title != "" || meta.Description != "" || meta.Password != "" || len(meta.KeyWords) > 0 {
+		front, err := yaml.Marshal(meta)
+		if err != nil {
+			return nil, err
+		}
+		dir.WriteString("---\n")
+		dir.Write(front)
+		dir.WriteString("---\n")
+	}
+	if index != "" {
+		dir.Write(body)
+		dir.WriteString("\n\n")
+	} else if meta.Description != "" {
+		dir.WriteString(meta.Description)
+		dir.WriteString("\n\n")
+	}
+	listing, err := buildListing(fsys, path, index, dirMeta, options, hide, private, blogRouter, blogPath)
+	if err != nil {
+		return nil, err
+	}
+	dir.Write(renderListing(listing))
+	return dir.Bytes(), nil
+}
+
+// 目录中可见的项,按 options 和 _dir.yaml 排序和分页;作为首页的文件不在其中
+func ListDir(path string, options DirOptions, hide, private GitIgnorer, blogRouter, blogPath string) (DirListing, error) {
+	return listDir(OsFS, path, options, hide, private, blogRouter, blogPath)
+}
+
+func listDir(fsys BlogFS, path string, options DirOptions, hide, private GitIgnorer, blogRouter, blogPath string) (DirListing, error) {
+	path = SimplifyPath(path)
+	dirMeta, err := loadDirMeta(fsys, path)
+	if err != nil {
+		return DirListing{}, err
+	}
+	index := dirIndex(fsys, path)
+	if index != "" && PathMatch(index, hide, private) {
+		index = ""
+	}
+	return buildListing(fsys, path, index, dirMeta, options, hide, private, blogRouter, blogPath)
+}
+
+func buildListing(fsys BlogFS, path, index string, dirMeta DirMeta, options DirOptions, hide, private GitIgnorer, blogRouter, blogPath string) (DirListing, error) {
+	entries, err := visibleEntries(fsys, path, dirMeta, hide, private)
+	if err != nil {
+		return DirListing{}, err
+	}
+	items := []DirItem{}
+	for _, entry := range entries {
+		full_path := SimplifyPath(path + "/" + entry.Name())
+		if full_path == index {
+			continue
+		}
+		items = append(items, dirItem(fsys, full_path, entry, hide, private, blogRouter, blogPath))
+	}
+	listing := DirListing{Sort: options.Sort, Total: len(items), Page: max(1, options.Page), Pages: 1}
+	if listing.Sort == "" {
+		listing.Sort = dirMeta.Sort
+	}
+	if listing.Sort == "" {
+		listing.Sort = DIR_SORT_NAME
+	}
+	sortDirItems(items, listing.Sort, dirMeta.Order)
+	pageSize := dirMeta.PageSize
+	if pageSize <= 0 {
+		pageSize = options.PageSize
+	}
+	if pageSize > 0 && !options.All && len(items) > 0 {
+		listing.Pages = (len(items) + pageSize - 1) / pageSize
+		start := min((listing.Page-1)*pageSize, len(items))
+		items = items[start:min(start+pageSize, len(items))]
+	}
+	// 最后一页之后的页不存在
+	if !options.All && listing.Page > listing.Pages {
+		return DirListing{}, fmt.Errorf("%w: page %d of %d in %s", fs.ErrNotExist, listing.Page, listing.Pages, path)
+	}
+	listing.Items = items
+	return listing, nil
+}
+
+func visibleEntries(fsys BlogFS, path string, dirMeta DirMeta, hide, private GitIgnorer) ([]fs.DirEntry, error) {
+	entries, err := fsys.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	return FilterSlice(entries, func(entry fs.DirEntry) bool {
+		full_path := SimplifyPath(path + "/" + entry.Name())
+		if entry.Name() == DIR_META_FILE {
+			return false
+		}
+		for _, pattern := range dirMeta.Hidden {
+			if matched, _ := filepath.Match(pattern, entry.Name()); matched {
+				return false
+			}
+		}
+		if PathMatch(full_path, hide, private) {
+			log.Println("[load md] path in dir ignored:", full_path)
+			return false
+		}
+		return true
+	}), nil
+}
+
+// 读取子项的标题,描述,日期等,读取失败时只使用文件名
+func dirItem(fsys BlogFS, full_path string, entry fs.DirEntry, hide, private GitIgnorer, blogRouter, blogPath string) DirItem {
+	name := entry.Name()
+	item := DirItem{Name: name, Url: blogRouter + full_path[len(blogPath):], IsDir: entry.IsDir(), Title: name}
+	if info, err := entry.Info(); err == nil {
+		item.Date = info.ModTime()
+	}
+	var meta Meta
+	if entry.IsDir() {
+		item.Url += "/"
+		item.Title += "/"
+		dirMeta, err := loadDirMeta(fsys, full_path)
+		if err != nil {
+			return item
+		}
+		if index := dirIndex(fsys, full_path); index != "" && !PathMatch(index, hide, private) {
+			if content, err := fsys.ReadFile(index); err == nil {
+				meta, _ = MdMeta(content)
+			}
+		}
+		if dirMeta.Title != "" {
+			meta.Title = dirMeta.Title
+		}
+		if dirMeta.Description != "" {
+			meta.Description = dirMeta.Description
+		}
+		if entries, err := visibleEntries(fsys, full_path, dirMeta, hide, private); err == nil {
+			item.Count = len(entries)
+		}
+	} else if IsMdPath(name) {
+		content, err := fsys.ReadFile(full_path)
+		if err != nil {
+			return item
+		}
+		meta, _ = MdMeta(content)
+		if meta.Password == "" {
+			item.ReadingTime = ReadingTime(WordCount(string(frontMatterRe.ReplaceAll(content, nil))))
+		}
+		item.Title = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	if meta.Title != "" {
+		item.Title = meta.Title
+	}
+	// 受保护的博客的描述可能泄露内容
+	if meta.Password == "" {
+		item.Description = meta.Description
+	}
+	if date, ok := meta.Time(); ok {
+		item.Date = date
+	}
+	item.Weight = meta.Weight
+	return item
+}
+
+func sortDirItems(items []DirItem, sortBy string, orders []string) {
+	order := make(map[string]int, len(orders))
+	for i, name := range orders {
+		order[strings.TrimSuffix(name, "/")] = i
+	}
+	by := strings.TrimPrefix(sortBy, "-")
+	desc := strings.HasPrefix(sortBy, "-")
+	sort.SliceStable(items, func(i, j int) bool {
+		oi, iOrdered := order[items[i].Name]
+		oj, jOrdered := order[items[j].Name]
+		if iOrdered || jOrdered {
+			return iOrdered && (!jOrdered || oi < oj)
+		}
+		a, b := items[i], items[j]
+		if desc {
+			a, b = b, a
+		}
+		switch {
+		case by == DIR_SORT_DATE && !a.Date.Equal(b.Date):
+			return a.Date.Before(b.Date)
+		case by == DIR_SORT_WEIGHT && a.Weight != b.Weight:
+			return a.Weight < b.Weight
+		}
+		return a.Name < b.Name
+	})
+}
+
+func renderListing(listing DirListing) []byte {
+	var dir bytes.Buffer
+	dir.WriteString("<ul class=\"eb-dir-listing\">\n")
+	for _, item := range listing.Items {
+		dir.WriteString(fmt.Sprintf("<li><a href=\"%s\">%s</a>", html.EscapeString(item.Url), html.EscapeString(item.Title)))
+		var notes []string
+		if !item.Date.IsZero() {
+			notes = append(notes, item.Date.Format("2006-01-02"))
+		}
+		if item.ReadingTime > 0 {
+			notes = append(notes, fmt.Sprintf("%d min", item.ReadingTime))
+		}
+		if item.IsDir && item.Count == 1 {
+			notes = append(notes, "1 item")
+		} else if item.IsDir {
+			notes = append(notes, fmt.Sprintf("%d items", item.Count))
+		}
+		dir.WriteString(fmt.Sprintf(" <span class=\"eb-dir-meta\">%s</span>", strings.Join(notes, " · ")))
+		if item.Description != "" {
+			dir.WriteString(fmt.Sprintf("<br><span class=\"eb-dir-desc\">%s</span>", html.EscapeString(item.Description)))
+		}
+		dir.WriteString("</li>\n")
+	}
+	dir.WriteString("</ul>\n")
+	if listing.Pages > 1 {
+		page := func(n int) string {
+			query := url.Values{"page": {strconv.Itoa(n)}, "sort": {listing.Sort}}
+			return html.EscapeString("?" + query.Encode())
+		}
+		dir.WriteString("<p class=\"eb-dir-pages\">")
+		if listing.Page > 1 {
+			dir.WriteString(fmt.Sprintf("<a href=\"%s\">&laquo; prev</a> ", page(listing.Page-1)))
+		}
+		dir.WriteString(fmt.Sprintf("%d / %d", listing.Page, listing.Pages))
+		if listing.Page < listing.Pages {
+			dir.WriteString(fmt.Sprintf(" <a href=\"%s\">next &raquo;</a>", page(listing.Page+1)))
+		}
+		dir.WriteString("</p>\n")
+	}
+	return dir.Bytes()
+}
+
+// 使用 loader 的设置列出目录
+func (loader *BlogLoader) ListDir(path string, options DirOptions) (DirListing, error) {
+	loader.RLock()
+	defer loader.RUnlock()
+	if options.PageSize == 0 {
+		options.PageSize = loader.DirPageSize
+	}
+	return listDir(orOsFS(loader.FS), path, options, loader.Hide, loader.Private, loader.BlogRouter, loader.BlogPath)
+}
@@ -0,0 +1,214 @@
+package pkg
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cncsmonster/fspider"
+	"github.com/easy-projects/easyblog/pkg/log"
+)
+
+// === file events ===
+
+const (
+	FILE_CREATED = 1 << iota
+	FILE_MODIFIED
+	FILE_DELETED
+	FILE_RENAMED
+)
+
+type FileEvent struct {
+	Kind int
+	Path string
+	// 重命名之前的路径,只有 FILE_RENAMED 有
+	OldPath string
+}
+
+// 只订阅 spider 一次,把文件变化合并后分发给所有订阅者,每个订阅者都会收到全部事件
+type EventBus interface {
+	// 订阅文件事件,每次收到的是一段时间内合并后的一批事件,channel 在 Close 后关闭;
+	// Close 时剩下的事件最多等待订阅者一秒
+	Subscribe(name string) <-chan []FileEvent
+	Close()
+}
+
+type eventBusImpl struct {
+	mux         *sync.Mutex
+	subscribers []*subscriber
+	// 已知的路径,用来区分创建,修改和删除
+	known    map[string]os.FileInfo
+	debounce time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+type subscriber struct {
+	name    string
+	mux     *sync.Mutex
+	pending []FileEvent
+	notify  chan struct{}
+	out     chan []FileEvent
+}
+
+// debounce 为合并事件的时间窗口,一批事件最多等待 10 个窗口
+func NewEventBus(spider fspider.Spider, debounce time.Duration) EventBus {
+	bus := &eventBusImpl{
+		mux:      &sync.Mutex{},
+		known:    make(map[string]os.FileInfo),
+		debounce: debounce,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	for _, path := range spider.AllPaths() {
+		path = SimplifyPath(path)
+		if stat, err := os.Stat(path); err == nil {
+			bus.known[path] = stat
+		}
+	}
+	go bus.run(spider.FilesChanged())
+	return bus
+}
+
+func (bus *eventBusImpl) Subscribe(name string) <-chan []FileEvent {
+	sub := &subscriber{name: name, mux: &sync.Mutex{}, notify: make(chan struct{}, 1), out: make(chan []FileEvent)}
+	bus.mux.Lock()
+	bus.subscribers = append(bus.subscribers, sub)
+	bus.mux.Unlock()
+	go sub.pump(bus.done)
+	return sub.out
+}
+
+func (bus *eventBusImpl) Close() {
+	select {
+	case <-bus.stop:
+	default:
+		close(bus.stop)
+	}
+	<-bus.done
+}
+
+func (bus *eventBusImpl) run(changed <-chan string) {
+	defer close(bus.done)
+	// 同一批中对同一路径的多次变化会被合并
+	batch := make(map[string]struct{})
+	var timer <-chan time.Time
+	var first time.Time
+	for {
+		select {
+		case path, ok := <-changed:
+			if !ok {
+				bus.flush(batch)
+				return
+			}
+			batch[SimplifyPath(path)] = struct{}{}
+			if len(batch) == 1 {
+				first = time.Now()
+			}
+			// 持续有变化时推迟分发,但不超过 10 个窗口
+			if time.Since(first) < 10*bus.debounce {
+				timer = time.After(bus.debounce)
+			}
+		case <-timer:
+			bus.flush(batch)
+			batch = make(map[string]struct{})
+			timer = nil
+		case <-bus.stop:
+			bus.flush(batch)
+			return
+		}
+	}
+}
+
+func (bus *eventBusImpl) flush(batch map[string]struct{}) {
+	if len(batch) == 0 {
+		return
+	}
+	var created, others []FileEvent
+	deleted := make(map[string]os.FileInfo)
+	for path := range batch {
+		old, wasKnown := bus.known[path]
+		stat, err := os.Stat(path)
+		switch {
+		case err != nil && wasKnown:
+			delete(bus.known, path)
+			deleted[path] = old
+		case err != nil:
+			// 创建后又马上删除的临时文件
+		case wasKnown:
+			bus.known[path] = stat
+			others = append(others, FileEvent{Kind: FILE_MODIFIED, Path: path})
+		default:
+			bus.known[path] = stat
+			created = append(created, FileEvent{Kind: FILE_CREATED, Path: path})
+		}
+	}
+	// 同一批中被删除和被创建的是同一个文件,认为是重命名;inode 可能被复用,所以还要比较大小和修改时间
+	for i, event := range created {
+		for oldPath, old := range deleted {
+			stat := bus.known[event.Path]
+			if os.SameFile(old, stat) && old.Size() == stat.Size() && old.ModTime().Equal(stat.ModTime()) {
+				created[i] = FileEvent{Kind: FILE_RENAMED, Path: event.Path, OldPath: oldPath}
+				delete(deleted, oldPath)
+				break
+			}
+		}
+	}
+	events := append(created, others...)
+	for path := range deleted {
+		events = append(events, FileEvent{Kind: FILE_DELETED, Path: path})
+	}
+	log.Println("[event] publish:", len(events), "events")
+	bus.mux.Lock()
+	for _, sub := range bus.subscribers {
+		sub.push(events)
+	}
+	bus.mux.Unlock()
+}
+
+// 订阅者的队列不限长度,慢的订阅者不会阻塞其他订阅者,也不会丢失事件
+func (sub *subscriber) push(events []FileEvent) {
+	sub.mux.Lock()
+	sub.pending = append(sub.pending, events...)
+	sub.mux.Unlock()
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+// 关闭后把剩下的事件发完,已经不再读取的订阅者最多等待一秒,之后丢弃剩下的事件,不会泄漏 goroutine
+func (sub *subscriber) pump(done <-chan struct{}) {
+	defer close(sub.out)
+	take := func() []FileEvent {
+		sub.mux.Lock()
+		defer sub.mux.Unlock()
+		events := sub.pending
+		sub.pending = nil
+		return events
+	}
+	for stopping := false; !stopping; {
+		select {
+		case <-sub.notify:
+			if events := take(); len(events) > 0 {
+				select {
+				case sub.out <- events:
+				case <-done:
+					sub.mux.Lock()
+					sub.pending = append(events, sub.pending...)
+					sub.mux.Unlock()
+					stopping = true
+				}
+			}
+		case <-done:
+			stopping = true
+		}
+	}
+	if events := take(); len(events) > 0 {
+		select {
+		case sub.out <- events:
+		case <-time.After(time.Second):
+			log.Println("[event] subscriber not reading, drop:", sub.name, len(events), "events")
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package pkg
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cncsmonster/fspider"
+)
+
+// === blog fs ===
+
+// BlogLoader 读取文件的方式,参数都是 BLOG_PATH 下的完整路径
+type BlogFS interface {
+	Stat(path string) (fs.FileInfo, error)
+	ReadFile(path string) ([]byte, error)
+	ReadDir(path string) ([]fs.DirEntry, error)
+}
+
+// 直接读取磁盘
+var OsFS BlogFS = osFS{}
+
+type osFS struct{}
+
+func (osFS) Stat(path string) (fs.FileInfo, error) {
+	return os.Stat(path)
+}
+
+func (osFS) ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+func (osFS) ReadDir(path string) ([]fs.DirEntry, error) {
+	return os.ReadDir(path)
+}
+
+func (osFS) Open(path string) (io.ReadSeekCloser, error) {
+	return os.Open(path)
+}
+
+// 可以流式读取文件的 BlogFS,大文件不必读入内存
+type BlogFileOpener interface {
+	Open(path string) (io.ReadSeekCloser, error)
+}
+
+// fsys 为 nil 时返回 OsFS
+func orOsFS(fsys BlogFS) BlogFS {
+	if fsys == nil {
+		return OsFS
+	}
+	return fsys
+}
+
+// 不在磁盘上的文件的信息
+type fileInfo struct {
+	name    string
+	size    int64
+	dir     bool
+	modTime time.Time
+}
+
+func (info fileInfo) Name() string       { return info.name }
+func (info fileInfo) Size() int64        { return info.size }
+func (info fileInfo) ModTime() time.Time { return info.modTime }
+func (info fileInfo) IsDir() bool        { return info.dir }
+func (info fileInfo) Sys() any           { return nil }
+func (info fileInfo) Mode() fs.FileMode {
+	if info.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+// 和 filepath.WalkDir 相同,但是通过 fsys 读取目录
+func WalkBlogFS(fsys BlogFS, root string, fn fs.WalkDirFunc) error {
+	root = SimplifyPath(root)
+	stat, err := fsys.Stat(root)
+	if err != nil {
+		err = fn(root, nil, err)
+	} else {
+		err = walkBlogFS(fsys, root, fs.FileInfoToDirEntry(stat), fn)
+	}
+	if err == filepath.SkipDir || err == filepath.SkipAll {
+		return nil
+	}
+	return err
+}
+
+func walkBlogFS(fsys BlogFS, path string, d fs.DirEntry, fn fs.WalkDirFunc) error {
+	if err := fn(path, d, nil); err != nil || !d.IsDir() {
+		if err == filepath.SkipDir && d.IsDir() {
+			err = nil
+		}
+		return err
+	}
+	entries, err := fsys.ReadDir(path)
+	if err != nil {
+		if err = fn(path, d, err); err != nil {
+			if err == filepath.SkipDir {
+				err = nil
+			}
+			return err
+		}
+	}
+	for _, entry := range entries {
+		if err := walkBlogFS(fsys, SimplifyPath(path+"/"+entry.Name()), entry, fn); err != nil {
+			if err == filepath.SkipDir {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// === io/fs ===
+
+// BLOG_FS 的取值
+const (
+	// 直接读取 BLOG_PATH,默认值
+	BLOG_FS_OS = "os"
+	// 读取 BLOG_ZIP 这个 zip 文件,博客在 zip 的根目录
+	BLOG_FS_ZIP = "zip"
+	// 读取编译进程序的 EmbeddedBlog
+	BLOG_FS_EMBED = "embed"
+)
+
+// 编译进程序的博客,为 nil 时不能使用 BLOG_FS_EMBED
+var EmbeddedBlog fs.FS
+
+// 把 fsys 的根目录作为 root,root 是博客中的路径使用的前缀,不需要在磁盘上存在
+func NewBlogFS(fsys fs.FS, root string) BlogFS {
+	return &ioFSImpl{fsys: fsys, root: SimplifyPath(root)}
+}
+
+type ioFSImpl struct {
+	fsys fs.FS
+	root string
+}
+
+// 完整路径转换为 fsys 中的名字
+func (f *ioFSImpl) name(op, path string) (string, error) {
+	path = SimplifyPath(path)
+	if path == f.root {
+		return ".", nil
+	}
+	if !strings.HasPrefix(path, f.root+"/") {
+		return "", &fs.PathError{Op: op, Path: path, Err: fs.ErrNotExist}
+	}
+	return path[len(f.root)+1:], nil
+}
+
+func (f *ioFSImpl) Stat(path string) (fs.FileInfo, error) {
+	name, err := f.name("stat", path)
+	if err != nil {
+		return nil, err
+	}
+	return fs.Stat(f.fsys, name)
+}
+
+func (f *ioFSImpl) ReadFile(path string) ([]byte, error) {
+	name, err := f.name("read", path)
+	if err != nil {
+		return nil, err
+	}
+	return fs.ReadFile(f.fsys, name)
+}
+
+func (f *ioFSImpl) ReadDir(path string) ([]fs.DirEntry, error) {
+	name, err := f.name("readdir", path)
+	if err != nil {
+		return nil, err
+	}
+	return fs.ReadDir(f.fsys, name)
+}
+
+// zip 文件在程序退出前一直打开
+func NewZipFS(zipPath, root string) (BlogFS, error) {
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewBlogFS(reader, root), nil
+}
+
+// 按配置得到 BlogLoader 和搜索使用的 BlogFS,BLOG_FS_OS 时返回 nil
+func OpenBlogFS(config *Config) (BlogFS, error) {
+	config.RLock()
+	defer config.RUnlock()
+	switch config.BLOG_FS {
+	case BLOG_FS_ZIP:
+		return NewZipFS(config.BLOG_ZIP, config.BLOG_PATH)
+	case BLOG_FS_EMBED:
+		if EmbeddedBlog == nil {
+			return nil, fmt.Errorf("no blog embedded in this binary")
+		}
+		return NewBlogFS(EmbeddedBlog, config.BLOG_PATH), nil
+	}
+	return nil, nil
+}
+
+// 内容不会变化的 BlogFS 的 spider,只列出其中的文件,不会有文件变化
+func NewFSSpider(fsys BlogFS, root string) fspider.Spider {
+	spider := &fsSpiderImpl{once: &sync.Once{}, changed: make(chan string)}
+	WalkBlogFS(fsys, root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		spider.paths = append(spider.paths, path)
+		if d.IsDir() {
+			spider.dirs = append(spider.dirs, path)
+		} else {
+			spider.files = append(spider.files, path)
+		}
+		return nil
+	})
+	return spider
+}
+
+type fsSpiderImpl struct {
+	once    *sync.Once
+	changed chan string
+	paths   []string
+	files   []string
+	dirs    []string
+}
+
+func (s *fsSpiderImpl) Spide(path string) error     { return nil }
+func (s *fsSpiderImpl) UnSpide(path string) error   { return nil }
+func (s *fsSpiderImpl) FilesChanged() <-chan string { return s.changed }
+func (s *fsSpiderImpl) AllPaths() []string          { return slices.Clone(s.paths) }
+func (s *fsSpiderImpl) AllFiles() []string          { return slices.Clone(s.files) }
+func (s *fsSpiderImpl) AllDirs() []string           { return slices.Clone(s.dirs) }
+func (s *fsSpiderImpl) Stop()                       { s.once.Do(func() { close(s.changed) }) }
+
+// === git fs ===
+
+type gitEntry struct {
+	object string
+	size   int64
+	dir    bool
+	// 子项的名字,只有目录有
+	children []string
+}
+
+// 提交中的 BLOG_PATH,文件从 git 的对象库中读取,不受工作区的影响
+type gitFSImpl struct {
+	blogPath string
+	modTime  time.Time
+	// key 是相对于 BLOG_PATH 的路径,BLOG_PATH 本身是 "."
+	entries map[string]*gitEntry
+}
+
+// commit 应该是 ResolveGitRef 得到的提交;符号链接和子模块被忽略,修改时间都是提交的时间
+func NewGitFS(blogPath, commit string) (BlogFS, error) {
+	blogPath = SimplifyPath(blogPath)
+	if !commitRe.MatchString(commit) {
+		return nil, fmt.Errorf("bad commit: %s", commit)
+	}
+	out, err := gitOutput(blogPath, "log", "-1", "--format=%cI", commit)
+	if err != nil {
+		return nil, err
+	}
+	modTime, _ := time.Parse(time.RFC3339, strings.TrimSpace(string(out)))
+	if out, err = gitOutput(blogPath, "ls-tree", "--full-tree", "-r", "-t", "-l", "-z", commit+":./"); err != nil {
+		return nil, fmt.Errorf("blog path not in %s: %w", commit, err)
+	}
+	g := &gitFSImpl{blogPath: blogPath, modTime: modTime, entries: map[string]*gitEntry{".": {dir: true}}}
+	// <mode> <type> <object> <size>\t<path>
+	for _, record := range bytes.Split(out, []byte{0}) {
+		header, name, found := strings.Cut(string(record), "\t")
+		fields := strings.Fields(header)
+		if !found || len(fields) != 4 {
+			continue
+		}
+		entry := &gitEntry{object: fields[2], dir: fields[1] == "tree"}
+		if fields[1] == "blob" && fields[0] != "120000" {
+			entry.size, _ = strconv.ParseInt(fields[3], 10, 64)
+		} else if !entry.dir {
+			continue
+		}
+		g.entries[name] = entry
+	}
+	for name := range g.entries {
+		if name == "." {
+			continue
+		}
+		if parent, found := g.entries[path.Dir(name)]; found {
+			parent.children = append(parent.children, path.Base(name))
+		}
+	}
+	return g, nil
+}
+
+func (g *gitFSImpl) entry(op, name string) (string, *gitEntry, error) {
+	name = SimplifyPath(name)
+	rel := "."
+	if name != g.blogPath {
+		if !strings.HasPrefix(name, g.blogPath+"/") {
+			return "", nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+		}
+		rel = name[len(g.blogPath)+1:]
+	}
+	entry, found := g.entries[rel]
+	if !found {
+		return "", nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return rel, entry, nil
+}
+
+func (g *gitFSImpl) Stat(name string) (fs.FileInfo, error) {
+	rel, entry, err := g.entry("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return fileInfo{name: path.Base(rel), size: entry.size, dir: entry.dir, modTime: g.modTime}, nil
+}
+
+func (g *gitFSImpl) ReadFile(name string) ([]byte, error) {
+	_, entry, err := g.entry("read", name)
+	if err != nil {
+		return nil, err
+	}
+	if entry.dir {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+	return gitOutput(g.blogPath, "cat-file", "blob", entry.object)
+}
+
+func (g *gitFSImpl) ReadDir(name string) ([]fs.DirEntry, error) {
+	rel, entry, err := g.entry("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	entries := make([]fs.DirEntry, 0, len(entry.children))
+	for _, child := range entry.children {
+		childEntry := g.entries[path.Join(rel, child)]
+		entries = append(entries, fs.FileInfoToDirEntry(fileInfo{name: child, size: childEntry.size, dir: childEntry.dir, modTime: g.modTime}))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
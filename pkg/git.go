@@ -0,0 +1,198 @@
+package pkg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	fsutil "github.com/cncsmonster/gofsutil"
+	"github.com/easy-projects/easyblog/pkg/log"
+)
+
+// === git meta ===
+
+// 提交历史的缓存文件,在 APP_DATA_PATH 中
+const GIT_META_FILE = "git_meta.json"
+
+// 从提交历史得到的文件信息
+type GitInfo struct {
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+	// 按第一次提交的先后排列
+	Authors []string `json:"authors"`
+}
+
+// BLOG_PATH 在 git 仓库中时,从提交历史得到每个文件的创建和更新时间以及作者
+type GitMeta interface {
+	// 文件的提交历史,没有提交过时返回 false
+	Info(path string) (GitInfo, bool)
+	// 读取新的提交,返回提交历史变化了的文件
+	Refresh() []string
+}
+
+type gitState struct {
+	Head string `json:"head"`
+	// key 是相对于 BLOG_PATH 的路径
+	Files map[string]*GitInfo `json:"files"`
+}
+
+type gitMetaImpl struct {
+	mux      *sync.RWMutex
+	blogPath string
+	file     string
+	state    *gitState
+}
+
+// BLOG_PATH 不在 git 仓库中时返回 nil
+func NewGitMeta(blogPath, appDataPath string) GitMeta {
+	blogPath = SimplifyPath(blogPath)
+	if out, err := gitOutput(blogPath, "rev-parse", "--is-inside-work-tree"); err != nil || strings.TrimSpace(string(out)) != "true" {
+		return nil
+	}
+	g := &gitMetaImpl{
+		mux:      &sync.RWMutex{},
+		blogPath: blogPath,
+		file:     SimplifyPath(appDataPath + "/" + GIT_META_FILE),
+		state:    &gitState{Files: make(map[string]*GitInfo)},
+	}
+	if bs, err := os.ReadFile(g.file); err == nil {
+		var state gitState
+		if err := json.Unmarshal(bs, &state); err == nil && state.Files != nil {
+			g.state = &state
+		}
+	}
+	g.Refresh()
+	log.Println("[git] load:", len(g.state.Files), "files")
+	return g
+}
+
+func gitOutput(dir string, args ...string) ([]byte, error) {
+	args = append([]string{"-C", dir, "-c", "core.quotePath=false"}, args...)
+	return exec.Command("git", args...).Output()
+}
+
+// 把分支,标签或提交解析为完整的提交 hash
+func ResolveGitRef(blogPath, ref string) (string, error) {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return "", fmt.Errorf("bad ref: %s", ref)
+	}
+	out, err := gitOutput(SimplifyPath(blogPath), "rev-parse", "--verify", "--quiet", "--end-of-options", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("unknown ref: %s", ref)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// git 为 nil 时返回 false
+func gitInfoOf(git GitMeta, path string) (GitInfo, bool) {
+	if git == nil {
+		return GitInfo{}, false
+	}
+	return git.Info(path)
+}
+
+func (g *gitMetaImpl) Info(path string) (GitInfo, bool) {
+	path = SimplifyPath(path)
+	if !strings.HasPrefix(path, g.blogPath+"/") {
+		return GitInfo{}, false
+	}
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	info, found := g.state.Files[path[len(g.blogPath)+1:]]
+	if !found {
+		return GitInfo{}, false
+	}
+	return GitInfo{Created: info.Created, Updated: info.Updated, Authors: slices.Clone(info.Authors)}, true
+}
+
+func (g *gitMetaImpl) Refresh() []string {
+	out, err := gitOutput(g.blogPath, "rev-parse", "HEAD")
+	if err != nil {
+		// 还没有提交
+		return nil
+	}
+	head := strings.TrimSpace(string(out))
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	if head == g.state.Head {
+		return nil
+	}
+	// 只读取新的提交,历史被改写时重新读取全部
+	args := []string{"log", "--reverse", "--no-renames", "--relative", "--name-only", "--format=%x1e%aI%x1f%an"}
+	full := g.state.Head == "" || func() bool {
+		_, err := gitOutput(g.blogPath, "merge-base", "--is-ancestor", g.state.Head, head)
+		return err != nil
+	}()
+	if full {
+		args = append(args, head)
+	} else {
+		args = append(args, g.state.Head+".."+head)
+	}
+	if out, err = gitOutput(g.blogPath, append(args, "--", ".")...); err != nil {
+		log.Println("[git] log failed:", err)
+		return nil
+	}
+	if full {
+		g.state.Files = make(map[string]*GitInfo)
+	}
+	changed := make(map[string]struct{})
+	for _, commit := range bytes.Split(out, []byte("\x1e")) {
+		lines := strings.Split(strings.TrimSpace(string(commit)), "\n")
+		header := strings.SplitN(lines[0], "\x1f", 2)
+		if len(header) != 2 {
+			continue
+		}
+		date, err := time.Parse(time.RFC3339, header[0])
+		if err != nil {
+			continue
+		}
+		author := header[1]
+		for _, name := range lines[1:] {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			info, found := g.state.Files[name]
+			if !found {
+				info = &GitInfo{Created: date}
+				g.state.Files[name] = info
+			}
+			if date.Before(info.Created) {
+				info.Created = date
+			}
+			if date.After(info.Updated) {
+				info.Updated = date
+			}
+			if !slices.Contains(info.Authors, author) {
+				info.Authors = append(info.Authors, author)
+			}
+			changed[name] = struct{}{}
+		}
+	}
+	g.state.Head = head
+	if bs, err := json.MarshalIndent(g.state, "", "  "); err == nil {
+		if err := fsutil.MustWrite(g.file, bs); err != nil {
+			log.Println("[git] save failed:", err)
+		}
+	}
+	paths := make([]string, 0, len(changed))
+	for name := range changed {
+		paths = append(paths, SimplifyPath(g.blogPath+"/"+name))
+	}
+	log.Println("[git] refresh:", head, len(paths), "files")
+	return paths
+}
+
+// 提交历史作为 pandoc 的元数据,模板中可以使用 $created$ $updated$ $for(authors)$
+func GitInfoVars(info GitInfo) map[string]any {
+	return map[string]any{
+		"created": info.Created.Format("2006-01-02"),
+		"updated": info.Updated.Format("2006-01-02"),
+		"authors": info.Authors,
+	}
+}
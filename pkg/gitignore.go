@@ -1,6 +1,11 @@
 package pkg
 
 import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 
 	ignore "github.com/sabhiram/go-gitignore"
@@ -9,6 +14,10 @@ import (
 type GitIgnorer interface {
 	AddPatterns(patterns ...string) GitIgnorer
 	CleanPatterns() GitIgnorer
+	// 加载(或重新加载)一个忽略文件,其中的规则只作用于该文件所在的目录,文件不存在时移除其规则
+	LoadFile(file string) GitIgnorer
+	// 和 LoadFile 相同,但是内容由调用方读取,content 为 nil 时移除其规则
+	LoadContent(file string, content []byte) GitIgnorer
 	Match(path string) bool
 }
 
@@ -16,10 +25,12 @@ type gitIgnorerImpl struct {
 	mux      *sync.RWMutex
 	ignore   *ignore.GitIgnore
 	patterns map[string]struct{}
+	// 忽略文件路径 -> 忽略文件中的规则
+	files map[string]*ignore.GitIgnore
 }
 
 func NewBlogIgnorer() GitIgnorer {
-	return &gitIgnorerImpl{mux: &sync.RWMutex{}, ignore: ignore.CompileIgnoreLines(), patterns: make(map[string]struct{})}
+	return &gitIgnorerImpl{mux: &sync.RWMutex{}, ignore: ignore.CompileIgnoreLines(), patterns: make(map[string]struct{}), files: make(map[string]*ignore.GitIgnore)}
 }
 
 func (bi *gitIgnorerImpl) AddPatterns(patterns ...string) GitIgnorer {
@@ -44,9 +55,184 @@ func (bi *gitIgnorerImpl) CleanPatterns() GitIgnorer {
 	return bi
 }
 
+func (bi *gitIgnorerImpl) LoadFile(file string) GitIgnorer {
+	bs, err := os.ReadFile(file)
+	if err != nil {
+		bs = nil
+	}
+	return bi.LoadContent(file, bs)
+}
+
+func (bi *gitIgnorerImpl) LoadContent(file string, bs []byte) GitIgnorer {
+	file = SimplifyPath(file)
+	bi.mux.Lock()
+	if bs == nil {
+		delete(bi.files, file)
+	} else {
+		lines := strings.Split(strings.ReplaceAll(string(bs), "\r\n", "\n"), "\n")
+		bi.files[file] = ignore.CompileIgnoreLines(lines...)
+	}
+	bi.mux.Unlock()
+	return bi
+}
+
 func (bi *gitIgnorerImpl) Match(path string) bool {
+	path = SimplifyPath(path)
 	bi.mux.RLock()
-	match := bi.ignore.MatchesPath(path)
-	bi.mux.RUnlock()
-	return match
+	defer bi.mux.RUnlock()
+	if bi.ignore.MatchesPath(path) {
+		return true
+	}
+	for file, ig := range bi.files {
+		dir := SimplifyPath(filepath.Dir(file))
+		rel := path
+		if dir != "." {
+			if !strings.HasPrefix(path, dir+"/") {
+				continue
+			}
+			rel = path[len(dir)+1:]
+		}
+		if ig.MatchesPath(rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// 多个 GitIgnorer 的并集,任一个匹配即匹配,规则的修改只作用于第一个
+type unionIgnorerImpl struct {
+	ignorers []GitIgnorer
+}
+
+func UnionIgnorer(first GitIgnorer, others ...GitIgnorer) GitIgnorer {
+	return &unionIgnorerImpl{ignorers: append([]GitIgnorer{first}, others...)}
+}
+
+func (ui *unionIgnorerImpl) AddPatterns(patterns ...string) GitIgnorer {
+	ui.ignorers[0].AddPatterns(patterns...)
+	return ui
+}
+
+func (ui *unionIgnorerImpl) CleanPatterns() GitIgnorer {
+	ui.ignorers[0].CleanPatterns()
+	return ui
+}
+
+func (ui *unionIgnorerImpl) LoadFile(file string) GitIgnorer {
+	ui.ignorers[0].LoadFile(file)
+	return ui
+}
+
+func (ui *unionIgnorerImpl) LoadContent(file string, content []byte) GitIgnorer {
+	ui.ignorers[0].LoadContent(file, content)
+	return ui
+}
+
+func (ui *unionIgnorerImpl) Match(path string) bool {
+	for _, ignorer := range ui.ignorers {
+		if ignorer.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// === ignore files in blog ===
+
+// 博客目录中的忽略文件,规则和 .gitignore 相同,作用于所在目录
+const (
+	HIDE_FILE       = ".ebhide"
+	PRIVATE_FILE    = ".ebprivate"
+	GIT_IGNORE_FILE = ".gitignore"
+)
+
+// 兼容 .ebignore 作为 .ebhide 的别名
+const HIDE_FILE_ALIAS = ".ebignore"
+
+// 如果 path 是一个忽略文件,重新加载到对应的 GitIgnorer 中,返回 path 是否是忽略文件
+func ReloadIgnoreFile(path string, hide, private GitIgnorer, gitignore bool) bool {
+	ignorer := ignorerOf(path, hide, private, gitignore)
+	if ignorer == nil {
+		return false
+	}
+	ignorer.LoadFile(path)
+	return true
+}
+
+// path 是忽略文件时返回它的规则所属的 GitIgnorer,否则返回 nil
+func ignorerOf(path string, hide, private GitIgnorer, gitignore bool) GitIgnorer {
+	switch filepath.Base(path) {
+	case HIDE_FILE, HIDE_FILE_ALIAS:
+		return hide
+	case PRIVATE_FILE:
+		return private
+	case GIT_IGNORE_FILE:
+		if gitignore {
+			return hide
+		}
+	}
+	return nil
+}
+
+// 加载 blogPath 下所有目录中的忽略文件
+func LoadIgnoreFiles(blogPath string, hide, private GitIgnorer, gitignore bool) error {
+	return LoadIgnoreFilesFS(OsFS, blogPath, hide, private, gitignore)
+}
+
+// 通过 fsys 读取忽略文件
+func LoadIgnoreFilesFS(fsys BlogFS, blogPath string, hide, private GitIgnorer, gitignore bool) error {
+	// 忽略文件和目录的元数据文件本身不应该被访问到
+	hide.AddPatterns(HIDE_FILE, HIDE_FILE_ALIAS, PRIVATE_FILE, DIR_META_FILE)
+	return WalkBlogFS(fsys, blogPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if ignorer := ignorerOf(path, hide, private, gitignore); ignorer != nil && !d.IsDir() {
+			content, err := fsys.ReadFile(path)
+			if err != nil {
+				content = nil
+			}
+			ignorer.LoadContent(path, content)
+		}
+		return nil
+	})
+}
+
+// 搜索插件使用的不可见路径规则: patterns 加上 paths 中被 hide 或 private 匹配的路径;
+// 忽略文件中的规则只作用于所在目录,所以展开成相对于 blogPath 的具体路径,已经排除的目录中的路径不再列出
+func ExcludePatterns(paths []string, blogPath string, hide, private GitIgnorer, patterns ...string) []string {
+	exclude := append([]string{}, patterns...)
+	blogPath = SimplifyPath(blogPath)
+	excluded := make(map[string]struct{})
+	rels := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = SimplifyPath(path)
+		if !PathMatch(path, hide, private) {
+			continue
+		}
+		rel, err := filepath.Rel(blogPath, path)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+		excluded[rel] = struct{}{}
+		rels = append(rels, rel)
+	}
+	slices.Sort(rels)
+	for _, rel := range rels {
+		inExcluded := false
+		for dir := filepath.ToSlash(filepath.Dir(rel)); dir != "."; dir = filepath.ToSlash(filepath.Dir(dir)) {
+			if _, found := excluded[dir]; found {
+				inExcluded = true
+				break
+			}
+		}
+		if !inExcluded {
+			exclude = append(exclude, "/"+rel)
+		}
+	}
+	return exclude
 }
@@ -0,0 +1,378 @@
+package pkg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	fsutil "github.com/cncsmonster/gofsutil"
+)
+
+// === revision history ===
+
+// 快照保存在 APP_DATA_PATH 中的这个目录下
+const SNAPSHOT_DIR = "snapshots"
+
+// 每个文件最多保留的快照数
+const SNAPSHOT_LIMIT = 50
+
+// 代表磁盘上当前内容的版本号
+const REVISION_CURRENT = "current"
+
+// 超过这个规模时不计算逐行的差异,整体替换
+const DIFF_MAX_CELLS = 1 << 24
+
+type Revision struct {
+	Id      string    `json:"id"`
+	Date    time.Time `json:"date"`
+	Author  string    `json:"author,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+// 博客的历史版本,来自 git 或者 easy-blog 保存的快照
+type RevisionStore interface {
+	// 文件的历史版本,从新到旧
+	Revisions(path string) ([]Revision, error)
+	// 某个版本的内容,id 为 REVISION_CURRENT 时读取磁盘上的文件
+	Content(path, id string) ([]byte, error)
+	// 文件变化时保存快照,使用 git 时不需要
+	Snapshot(path string) error
+}
+
+// BLOG_PATH 在 git 仓库中时使用提交历史,否则使用快照
+func NewRevisionStore(blogPath, appDataPath string) RevisionStore {
+	blogPath = SimplifyPath(blogPath)
+	if out, err := gitOutput(blogPath, "rev-parse", "--is-inside-work-tree"); err == nil && strings.TrimSpace(string(out)) == "true" {
+		return &gitRevisionsImpl{blogPath: blogPath}
+	}
+	return &snapshotRevisionsImpl{mux: &sync.Mutex{}, blogPath: blogPath, dir: SimplifyPath(appDataPath + "/" + SNAPSHOT_DIR)}
+}
+
+// 相对于 BLOG_PATH 的路径
+func relPath(blogPath, path string) (string, error) {
+	path = SimplifyPath(path)
+	if !strings.HasPrefix(path, blogPath+"/") {
+		return "", fmt.Errorf("path not in blog path: %s", path)
+	}
+	return path[len(blogPath)+1:], nil
+}
+
+var commitRe = regexp.MustCompile(`^[0-9a-f]{4,64}$`)
+
+type gitRevisionsImpl struct {
+	blogPath string
+}
+
+func (g *gitRevisionsImpl) Revisions(path string) ([]Revision, error) {
+	rel, err := relPath(g.blogPath, path)
+	if err != nil {
+		return nil, err
+	}
+	out, err := gitOutput(g.blogPath, "log", "--format=%h%x1f%aI%x1f%an%x1f%s", "--", rel)
+	if err != nil {
+		return nil, err
+	}
+	revisions := []Revision{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.SplitN(line, "\x1f", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, fields[1])
+		revisions = append(revisions, Revision{Id: fields[0], Date: date, Author: fields[2], Message: fields[3]})
+	}
+	return revisions, nil
+}
+
+func (g *gitRevisionsImpl) Content(path, id string) ([]byte, error) {
+	rel, err := relPath(g.blogPath, path)
+	if err != nil {
+		return nil, err
+	}
+	if id == REVISION_CURRENT {
+		return os.ReadFile(path)
+	}
+	// 只接受提交的 hash,防止把参数当作选项
+	if !commitRe.MatchString(id) {
+		return nil, fmt.Errorf("bad revision: %s", id)
+	}
+	return gitOutput(g.blogPath, "show", id+":./"+rel)
+}
+
+func (g *gitRevisionsImpl) Snapshot(path string) error {
+	return nil
+}
+
+type snapshotRevisionsImpl struct {
+	mux      *sync.Mutex
+	blogPath string
+	dir      string
+}
+
+// 每个文件的快照放在以路径的 hash 命名的目录中,文件名是保存时的纳秒时间戳
+func (s *snapshotRevisionsImpl) fileDir(path string) (string, error) {
+	rel, err := relPath(s.blogPath, path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(rel))
+	return s.dir + "/" + hex.EncodeToString(sum[:8]), nil
+}
+
+// 快照的 id,从新到旧
+func (s *snapshotRevisionsImpl) ids(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var ids []string
+	for _, entry := range entries {
+		if _, err := strconv.ParseInt(entry.Name(), 10, 64); err == nil {
+			ids = append(ids, entry.Name())
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.ParseInt(ids[i], 10, 64)
+		b, _ := strconv.ParseInt(ids[j], 10, 64)
+		return a > b
+	})
+	return ids
+}
+
+func (s *snapshotRevisionsImpl) Revisions(path string) ([]Revision, error) {
+	dir, err := s.fileDir(path)
+	if err != nil {
+		return nil, err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	revisions := []Revision{}
+	for _, id := range s.ids(dir) {
+		nano, _ := strconv.ParseInt(id, 10, 64)
+		revisions = append(revisions, Revision{Id: id, Date: time.Unix(0, nano)})
+	}
+	return revisions, nil
+}
+
+func (s *snapshotRevisionsImpl) Content(path, id string) ([]byte, error) {
+	if id == REVISION_CURRENT {
+		return os.ReadFile(path)
+	}
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, fmt.Errorf("bad revision: %s", id)
+	}
+	dir, err := s.fileDir(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(dir + "/" + id)
+}
+
+// 内容和最新的快照相同时不保存
+func (s *snapshotRevisionsImpl) Snapshot(path string) error {
+	dir, err := s.fileDir(path)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ids := s.ids(dir)
+	if len(ids) > 0 {
+		if latest, err := os.ReadFile(dir + "/" + ids[0]); err == nil && bytes.Equal(latest, content) {
+			return nil
+		}
+	}
+	nano := time.Now().UnixNano()
+	if len(ids) > 0 {
+		// 时钟回拨时保持顺序
+		latest, _ := strconv.ParseInt(ids[0], 10, 64)
+		nano = max(nano, latest+1)
+	}
+	id := strconv.FormatInt(nano, 10)
+	if err := fsutil.MustWrite(dir+"/"+id, content); err != nil {
+		return err
+	}
+	for _, old := range append([]string{id}, ids...)[min(SNAPSHOT_LIMIT, len(ids)+1):] {
+		os.Remove(dir + "/" + old)
+	}
+	return nil
+}
+
+// === diff ===
+
+const (
+	DIFF_SAME = iota
+	DIFF_DELETE
+	DIFF_INSERT
+)
+
+type DiffLine struct {
+	Kind int
+	Text string
+}
+
+// 逐行比较,基于最长公共子序列
+func Diff(a, b []byte) []DiffLine {
+	x, y := splitLines(a), splitLines(b)
+	// 去掉相同的开头和结尾,减少计算量
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+	var lines []DiffLine
+	for _, line := range x[:prefix] {
+		lines = append(lines, DiffLine{DIFF_SAME, line})
+	}
+	mx, my := x[prefix:len(x)-suffix], y[prefix:len(y)-suffix]
+	if (len(mx)+1)*(len(my)+1) > DIFF_MAX_CELLS {
+		for _, line := range mx {
+			lines = append(lines, DiffLine{DIFF_DELETE, line})
+		}
+		for _, line := range my {
+			lines = append(lines, DiffLine{DIFF_INSERT, line})
+		}
+	} else {
+		// lcs[i][j] 是 mx[i:] 和 my[j:] 的最长公共子序列的长度
+		n, m := len(mx), len(my)
+		lcs := make([]int32, (n+1)*(m+1))
+		at := func(i, j int) *int32 { return &lcs[i*(m+1)+j] }
+		for i := n - 1; i >= 0; i-- {
+			for j := m - 1; j >= 0; j-- {
+				if mx[i] == my[j] {
+					*at(i, j) = *at(i+1, j+1) + 1
+				} else {
+					*at(i, j) = max(*at(i+1, j), *at(i, j+1))
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < n && j < m {
+			switch {
+			case mx[i] == my[j]:
+				lines = append(lines, DiffLine{DIFF_SAME, mx[i]})
+				i, j = i+1, j+1
+			case *at(i+1, j) >= *at(i, j+1):
+				lines = append(lines, DiffLine{DIFF_DELETE, mx[i]})
+				i++
+			default:
+				lines = append(lines, DiffLine{DIFF_INSERT, my[j]})
+				j++
+			}
+		}
+		for ; i < n; i++ {
+			lines = append(lines, DiffLine{DIFF_DELETE, mx[i]})
+		}
+		for ; j < m; j++ {
+			lines = append(lines, DiffLine{DIFF_INSERT, my[j]})
+		}
+	}
+	for _, line := range x[len(x)-suffix:] {
+		lines = append(lines, DiffLine{DIFF_SAME, line})
+	}
+	return lines
+}
+
+func splitLines(content []byte) []string {
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// 统一格式的差异
+func RenderUnifiedDiff(lines []DiffLine) []byte {
+	var out bytes.Buffer
+	out.WriteString("<pre class=\"eb-diff\">")
+	for _, line := range lines {
+		switch line.Kind {
+		case DIFF_DELETE:
+			out.WriteString("<del>- " + html.EscapeString(line.Text) + "</del>\n")
+		case DIFF_INSERT:
+			out.WriteString("<ins>+ " + html.EscapeString(line.Text) + "</ins>\n")
+		default:
+			out.WriteString("  " + html.EscapeString(line.Text) + "\n")
+		}
+	}
+	out.WriteString("</pre>\n")
+	return out.Bytes()
+}
+
+// 左右对照的差异,相邻的删除和插入放在同一行
+func RenderSplitDiff(lines []DiffLine) []byte {
+	var out bytes.Buffer
+	out.WriteString("<table class=\"eb-diff-split\">\n")
+	cell := func(tag, text string) string {
+		if tag == "" {
+			return "<td><pre>" + html.EscapeString(text) + "</pre></td>"
+		}
+		return "<td><pre><" + tag + ">" + html.EscapeString(text) + "</" + tag + "></pre></td>"
+	}
+	for i := 0; i < len(lines); {
+		if lines[i].Kind == DIFF_SAME {
+			out.WriteString("<tr>" + cell("", lines[i].Text) + cell("", lines[i].Text) + "</tr>\n")
+			i++
+			continue
+		}
+		var deleted, inserted []string
+		for ; i < len(lines) && lines[i].Kind != DIFF_SAME; i++ {
+			if lines[i].Kind == DIFF_DELETE {
+				deleted = append(deleted, lines[i].Text)
+			} else {
+				inserted = append(inserted, lines[i].Text)
+			}
+		}
+		for k := 0; k < max(len(deleted), len(inserted)); k++ {
+			left, right := "<td></td>", "<td></td>"
+			if k < len(deleted) {
+				left = cell("del", deleted[k])
+			}
+			if k < len(inserted) {
+				right = cell("ins", inserted[k])
+			}
+			out.WriteString("<tr>" + left + right + "</tr>\n")
+		}
+	}
+	out.WriteString("</table>\n")
+	return out.Bytes()
+}
+
+// 历史版本列表,url 是博客的 url,每个版本可以和前一个版本以及当前内容比较
+func RenderHistory(revisions []Revision, url string) []byte {
+	var out bytes.Buffer
+	if len(revisions) == 0 {
+		out.WriteString("<p>no revisions</p>\n")
+		return out.Bytes()
+	}
+	diff := func(a, b string) string {
+		return html.EscapeString(url + "?diff=" + a + ".." + b)
+	}
+	out.WriteString("<table class=\"eb-history\">\n<tr><th>date</th><th>author</th><th>message</th><th>revision</th><th></th></tr>\n")
+	for i, rev := range revisions {
+		links := fmt.Sprintf("<a href=\"%s\">current</a>", diff(rev.Id, REVISION_CURRENT))
+		if i+1 < len(revisions) {
+			links = fmt.Sprintf("<a href=\"%s\">previous</a> ", diff(revisions[i+1].Id, rev.Id)) + links
+		}
+		out.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%s</td><td>%s</td><td><code>%s</code></td><td>%s</td></tr>\n",
+			rev.Date.Format("2006-01-02 15:04:05"), html.EscapeString(rev.Author), html.EscapeString(rev.Message), html.EscapeString(rev.Id), links))
+	}
+	out.WriteString("</table>\n")
+	return out.Bytes()
+}
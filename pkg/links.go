@@ -0,0 +1,452 @@
+package pkg
+
+import (
+	"bytes"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+)
+
+// === link graph ===
+
+// 指向一篇博客的链接
+type LinkRef struct {
+	Url   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// 博客之间的链接关系,支持 [[Page Name]] 和 [[path|label]] 形式的 wiki-link
+type LinkGraph interface {
+	// 读取所有 md 文件中的链接
+	Build(paths []string)
+	// 把 md 中的 wiki-link 替换为 markdown 链接,dir 为 md 所在的目录
+	ExpandWikiLinks(md []byte, dir string) []byte
+	// 解析 wiki-link 的目标,返回文件路径
+	Resolve(target, dir string) (string, bool)
+	// 文件变化后重新读取其中的链接,返回需要重新渲染的文件
+	Update(path string) []string
+	// 文件删除后,返回需要重新渲染的文件
+	Remove(path string) []string
+	// 文件中指向其他博客的链接
+	Links(path string) []string
+	// 指向该文件的可见的博客
+	Backlinks(path string) []LinkRef
+	// 所有可见的博客和它们之间的链接,private 为 true 时包括私有的博客
+	Graph(private bool) Graph
+}
+
+type linkNode struct {
+	title string
+	tags  []string
+	links []string
+	// links 经过符号链接后的路径,反向链接以它为键
+	keys []string
+	// 包含 wiki-link,其他文件增删时解析结果可能变化
+	wiki bool
+}
+
+type linkGraphImpl struct {
+	mux        *sync.RWMutex
+	blogPath   string
+	blogRouter string
+	hide       GitIgnorer
+	private    GitIgnorer
+	fsys       BlogFS
+	nodes      map[string]*linkNode
+	backlinks  map[string]map[string]struct{}
+}
+
+var (
+	wikiLinkRe = regexp.MustCompile(`\[\[([^\[\]|]+)(?:\|([^\[\]]+))?\]\]`)
+	mdLinkRe   = regexp.MustCompile(`\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	hrefRe     = regexp.MustCompile(`href="([^"]+)"`)
+)
+
+func NewLinkGraph(blogPath, blogRouter string, hide, private GitIgnorer) LinkGraph {
+	return NewLinkGraphFS(OsFS, blogPath, blogRouter, hide, private)
+}
+
+// 通过 fsys 读取文件
+func NewLinkGraphFS(fsys BlogFS, blogPath, blogRouter string, hide, private GitIgnorer) LinkGraph {
+	return &linkGraphImpl{
+		mux:        &sync.RWMutex{},
+		blogPath:   SimplifyPath(blogPath),
+		blogRouter: blogRouter,
+		hide:       hide,
+		private:    private,
+		fsys:       fsys,
+		nodes:      make(map[string]*linkNode),
+		backlinks:  make(map[string]map[string]struct{}),
+	}
+}
+
+func (g *linkGraphImpl) Build(paths []string) {
+	for _, path := range paths {
+		if path = SimplifyPath(path); IsMdPath(path) {
+			g.parse(path)
+		}
+	}
+	// 第一遍时还不知道所有的文件,wiki-link 需要再解析一次
+	g.mux.RLock()
+	var wikis []string
+	for path, node := range g.nodes {
+		if node.wiki {
+			wikis = append(wikis, path)
+		}
+	}
+	g.mux.RUnlock()
+	for _, path := range wikis {
+		g.parse(path)
+	}
+	log.Println("[links] build:", len(g.nodes), "blogs")
+}
+
+// 读取文件的标题和链接,返回受影响的文件
+func (g *linkGraphImpl) parse(path string) []string {
+	content, err := g.fsys.ReadFile(path)
+	if err != nil {
+		return g.Remove(path)
+	}
+	meta, _ := MdMeta(content)
+	title := meta.Title
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	dir := filepath.Dir(path)
+	md := g.ExpandWikiLinks(content, dir)
+	seen := make(map[string]struct{})
+	var links []string
+	mapProse(md, func(prose []byte) []byte {
+		for _, re := range []*regexp.Regexp{mdLinkRe, hrefRe} {
+			for _, match := range re.FindAllSubmatch(prose, -1) {
+				target, ok := g.urlPath(string(match[1]), dir)
+				if _, found := seen[target]; ok && !found && target != path {
+					seen[target] = struct{}{}
+					links = append(links, target)
+				}
+			}
+		}
+		return prose
+	})
+	keys := make([]string, len(links))
+	for i, target := range links {
+		keys[i] = g.realPath(target)
+	}
+	node := &linkNode{title: title, tags: meta.KeyWords, links: links, keys: keys, wiki: wikiLinkRe.Match(content)}
+
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	old, existed := g.nodes[path]
+	affected := append([]string{}, links...)
+	if existed {
+		affected = append(affected, old.links...)
+		for _, key := range old.keys {
+			delete(g.backlinks[key], path)
+		}
+	}
+	for _, key := range keys {
+		if g.backlinks[key] == nil {
+			g.backlinks[key] = make(map[string]struct{})
+		}
+		g.backlinks[key][path] = struct{}{}
+	}
+	g.nodes[path] = node
+	if !existed || old.title != title {
+		// 新的文件或标题变化,wiki-link 的解析结果可能变化
+		affected = append(affected, g.wikiNodes(path)...)
+	}
+	return affected
+}
+
+func (g *linkGraphImpl) wikiNodes(except string) (paths []string) {
+	for path, node := range g.nodes {
+		if node.wiki && path != except {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+func (g *linkGraphImpl) Update(path string) []string {
+	path = SimplifyPath(path)
+	if !IsMdPath(path) {
+		return nil
+	}
+	affected := g.parse(path)
+	return append(affected, g.reparseWiki(affected, path)...)
+}
+
+// wiki-link 的解析结果可能变化,重新解析这些文件的链接
+func (g *linkGraphImpl) reparseWiki(paths []string, except string) (affected []string) {
+	for _, other := range paths {
+		g.mux.RLock()
+		node, found := g.nodes[other]
+		g.mux.RUnlock()
+		if found && node.wiki && other != except {
+			affected = append(affected, g.parse(other)...)
+		}
+	}
+	return affected
+}
+
+func (g *linkGraphImpl) Remove(path string) []string {
+	path = SimplifyPath(path)
+	key := g.realPath(path)
+	g.mux.Lock()
+	old, existed := g.nodes[path]
+	if !existed {
+		g.mux.Unlock()
+		return nil
+	}
+	delete(g.nodes, path)
+	for _, key := range old.keys {
+		delete(g.backlinks[key], path)
+	}
+	affected := append(append([]string{}, old.links...), g.wikiNodes(path)...)
+	for source := range g.backlinks[key] {
+		affected = append(affected, source)
+	}
+	g.mux.Unlock()
+	return append(affected, g.reparseWiki(affected, path)...)
+}
+
+func (g *linkGraphImpl) Links(path string) []string {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	if node, found := g.nodes[SimplifyPath(path)]; found {
+		return append([]string{}, node.links...)
+	}
+	return nil
+}
+
+func (g *linkGraphImpl) Backlinks(path string) []LinkRef {
+	key := g.realPath(SimplifyPath(path))
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	refs := []LinkRef{}
+	for source := range g.backlinks[key] {
+		if PathMatch(source, g.hide, g.private) {
+			continue
+		}
+		refs = append(refs, LinkRef{Url: g.blogRouter + source[len(g.blogPath):], Title: g.nodes[source].title})
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Url < refs[j].Url })
+	return refs
+}
+
+// 图中的一篇博客
+type GraphNode struct {
+	Url   string   `json:"url"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags,omitempty"`
+	// 图中指向它和它指向的博客数
+	In  int `json:"in"`
+	Out int `json:"out"`
+	// 见 IsOrphan
+	Orphan bool `json:"orphan,omitempty"`
+}
+
+type GraphEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+func (g *linkGraphImpl) Graph(private bool) Graph {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	visible := func(path string) bool {
+		if _, found := g.nodes[path]; !found || PathMatch(path, g.hide) {
+			return false
+		}
+		return private || !PathMatch(path, g.private)
+	}
+	url := func(path string) string {
+		return g.blogRouter + path[len(g.blogPath):]
+	}
+	paths := make([]string, 0, len(g.nodes))
+	for path := range g.nodes {
+		if visible(path) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	graph := Graph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	index := make(map[string]int, len(paths))
+	for i, path := range paths {
+		node := g.nodes[path]
+		index[path] = i
+		graph.Nodes = append(graph.Nodes, GraphNode{Url: url(path), Title: node.title, Tags: node.tags})
+	}
+	linked := make(map[string]bool, len(paths))
+	for i, path := range paths {
+		for _, target := range g.nodes[path].links {
+			if j, found := index[target]; found {
+				graph.Edges = append(graph.Edges, GraphEdge{Source: url(path), Target: url(target)})
+				graph.Nodes[i].Out++
+				graph.Nodes[j].In++
+				if !PathMatch(path, g.private) {
+					linked[target] = true
+				}
+			}
+		}
+	}
+	for i, path := range paths {
+		graph.Nodes[i].Orphan = IsOrphan(path, linked[path], g.private)
+	}
+	return graph
+}
+
+// 经过符号链接后 path 在博客中的路径,同一篇博客的不同路径共用反向链接;不在磁盘上的文件没有符号链接
+func (g *linkGraphImpl) realPath(path string) string {
+	if _, ok := g.fsys.(osFS); !ok {
+		return path
+	}
+	return RealPathIn(g.blogPath, path)
+}
+
+// path 或它指向的真实路径是隐藏或私有的
+func (g *linkGraphImpl) invisible(path string) bool {
+	return PathMatch(path, g.hide, g.private) || PathMatch(g.realPath(path), g.hide, g.private)
+}
+
+// 孤立的博客: 没有被其他公开的博客链接的公开的 md 文件,链接检查和链接图使用同一个定义;
+// 读者看不到私有博客中的链接,私有博客本身也不需要被链接;目录首页可以从目录进入,不算孤立
+func IsOrphan(path string, linked bool, private GitIgnorer) bool {
+	return !linked && IsMdPath(path) && !slices.Contains(DIR_INDEX_FILES, filepath.Base(path)) && !PathMatch(path, private)
+}
+
+// 把链接转换为博客中 md 文件的路径,外部链接返回 false
+func (g *linkGraphImpl) urlPath(link, dir string) (string, bool) {
+	if i := strings.IndexAny(link, "#?"); i >= 0 {
+		link = link[:i]
+	}
+	link, err := url.PathUnescape(link)
+	if err != nil || link == "" || strings.Contains(link, ":") {
+		return "", false
+	}
+	var path string
+	if link == g.blogRouter || strings.HasPrefix(link, g.blogRouter+"/") {
+		path = g.blogPath + "/" + link[len(g.blogRouter):]
+	} else if strings.HasPrefix(link, "/") {
+		return "", false
+	} else {
+		path = dir + "/" + link
+	}
+	path = SimplifyPath(path)
+	if !IsMdPath(path) || !IsSubPath(g.blogPath, path) {
+		return "", false
+	}
+	return path, true
+}
+
+// 统一大小写,空格,- 和 _,用来按名字匹配
+func normalizeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("-", " ", "_", " ").Replace(name)
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func (g *linkGraphImpl) Resolve(target, dir string) (string, bool) {
+	target = strings.TrimSpace(target)
+	if strings.Contains(target, "/") || IsMdPath(target) {
+		// 路径: 以 / 开头时相对于博客根目录,否则相对于当前目录
+		path := dir + "/" + target
+		if strings.HasPrefix(target, "/") {
+			path = g.blogPath + target
+		}
+		path = SimplifyPath(path)
+		if !IsMdPath(path) {
+			path += ".md"
+		}
+		if !IsSubPath(g.blogPath, path) {
+			return "", false
+		}
+		// 和名字一样,不会解析到不可见的博客
+		if _, err := g.fsys.Stat(path); err != nil || g.invisible(path) {
+			return "", false
+		}
+		return path, true
+	}
+	// 名字: 匹配文件名或标题,同名时取路径最小的,不会解析到不可见的博客
+	name := normalizeName(target)
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	var found []string
+	for path, node := range g.nodes {
+		base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if (normalizeName(base) == name || normalizeName(node.title) == name) && !g.invisible(path) {
+			found = append(found, path)
+		}
+	}
+	if len(found) == 0 {
+		return "", false
+	}
+	sort.Strings(found)
+	return found[0], true
+}
+
+func (g *linkGraphImpl) ExpandWikiLinks(md []byte, dir string) []byte {
+	return mapProse(md, func(prose []byte) []byte {
+		return wikiLinkRe.ReplaceAllFunc(prose, func(link []byte) []byte {
+			match := wikiLinkRe.FindSubmatch(link)
+			target, anchor, _ := strings.Cut(string(match[1]), "#")
+			label := strings.TrimSpace(string(match[2]))
+			if label == "" {
+				label = strings.TrimSpace(string(match[1]))
+			}
+			path, ok := g.Resolve(target, dir)
+			if !ok {
+				return []byte(`<span class="eb-wikilink-missing">` + htmlEscaper.Replace(label) + `</span>`)
+			}
+			href := g.blogRouter + (&url.URL{Path: path[len(g.blogPath):]}).EscapedPath()
+			if anchor != "" {
+				href += "#" + url.PathEscape(strings.TrimSpace(anchor))
+			}
+			return []byte("[" + strings.NewReplacer("[", `\[`, "]", `\]`).Replace(label) + "](" + href + ")")
+		})
+	})
+}
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// 对 md 中代码以外的部分应用 f,代码块和行内代码保持不变
+func mapProse(md []byte, f func([]byte) []byte) []byte {
+	var out bytes.Buffer
+	var fence string
+	for _, line := range bytes.SplitAfter(md, []byte("\n")) {
+		trimmed := strings.TrimSpace(string(line))
+		if fence != "" {
+			out.Write(line)
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			out.Write(line)
+			continue
+		}
+		for i, part := range bytes.Split(line, []byte("`")) {
+			if i > 0 {
+				out.WriteByte('`')
+			}
+			if i%2 == 0 {
+				part = f(part)
+			}
+			out.Write(part)
+		}
+	}
+	return out.Bytes()
+}
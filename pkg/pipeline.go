@@ -0,0 +1,230 @@
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/shlex"
+)
+
+// === command pipeline ===
+
+// 插件命令的执行器,类似 shell 中的 a | b | c,但不经过 shell
+type Pipeline struct {
+	Stages [][]string
+	// 额外的环境变量,形如 KEY=VALUE
+	Env []string
+	// 整个管道的超时时间
+	Timeout time.Duration
+	// 最后一个命令输出的最大字节数
+	MaxOutput int
+}
+
+var (
+	ErrPipelineTimeout = errors.New("pipeline timeout")
+	ErrPipelineOutput  = errors.New("pipeline output exceeds limit")
+)
+
+// 把命令按照不在引号中的 | 切分,再把每一段按照 shell 的规则切分为参数
+func ParsePipeline(command string) ([][]string, error) {
+	var parts []string
+	var cur strings.Builder
+	var quote rune
+	escaped := false
+	for _, r := range command {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '\'' || r == '"'):
+			quote = r
+		case quote == 0 && r == '|':
+			parts = append(parts, cur.String())
+			cur.Reset()
+			continue
+		}
+		cur.WriteRune(r)
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unclosed quote in command: %s", command)
+	}
+	parts = append(parts, cur.String())
+	stages := make([][]string, 0, len(parts))
+	for _, part := range parts {
+		args, err := shlex.Split(part)
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 0 {
+			return nil, fmt.Errorf("empty command in pipeline: %s", command)
+		}
+		stages = append(stages, args)
+	}
+	return stages, nil
+}
+
+// 把参数中的 ${KEY} 替换为 vars 中的值,替换发生在切分参数之后,所以变量的值不会被再次切分;
+// 所有变量一次替换完,值中的 ${KEY} 不会再被替换;
+// guarded 中的变量不能作为命令本身,出现在参数开头时必须位于 -- 之后,防止其值被当作选项
+func ExpandStages(stages [][]string, vars map[string]string, guarded ...string) ([][]string, error) {
+	pairs := make([]string, 0, 2*len(vars))
+	for key, value := range vars {
+		pairs = append(pairs, "${"+key+"}", value)
+	}
+	replacer := strings.NewReplacer(pairs...)
+	expanded := make([][]string, 0, len(stages))
+	for _, stage := range stages {
+		args := make([]string, 0, len(stage))
+		afterDashes := false
+		for i, arg := range stage {
+			for _, key := range guarded {
+				if i == 0 && strings.Contains(arg, "${"+key+"}") {
+					return nil, fmt.Errorf("${%s} can not be used in the command name: %s", key, strings.Join(stage, " "))
+				}
+				if i > 0 && !afterDashes && strings.HasPrefix(arg, "${"+key+"}") {
+					return nil, fmt.Errorf("${%s} must be placed after -- in: %s", key, strings.Join(stage, " "))
+				}
+			}
+			if arg == "--" {
+				afterDashes = true
+			}
+			args = append(args, replacer.Replace(arg))
+		}
+		expanded = append(expanded, args)
+	}
+	return expanded, nil
+}
+
+// 运行管道,返回最后一个命令的输出;每个命令都会被等待回收,出错时错误中带有 stderr
+func (p Pipeline) Run(ctx context.Context) ([]byte, error) {
+	if len(p.Stages) == 0 {
+		return nil, errors.New("empty pipeline")
+	}
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	env := append(os.Environ(), p.Env...)
+	cmds := make([]*exec.Cmd, len(p.Stages))
+	stderrs := make([]*limitedBuffer, len(p.Stages))
+	stdout := &limitedBuffer{limit: p.MaxOutput, onExceed: func() { cancel(ErrPipelineOutput) }}
+	var files []*os.File
+	closeFiles := func() {
+		for _, f := range files {
+			f.Close()
+		}
+		files = nil
+	}
+	defer closeFiles()
+	for i, args := range p.Stages {
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Env = env
+		setProcessGroup(cmd)
+		cmd.Cancel = func() error { return killProcessGroup(cmd) }
+		cmd.WaitDelay = time.Second
+		stderrs[i] = &limitedBuffer{limit: 4096}
+		cmd.Stderr = stderrs[i]
+		if i > 0 {
+			r, w, err := os.Pipe()
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, r, w)
+			cmds[i-1].Stdout = w
+			cmd.Stdin = r
+		}
+		cmds[i] = cmd
+	}
+	cmds[len(cmds)-1].Stdout = stdout
+	started := 0
+	var startErr error
+	for _, cmd := range cmds {
+		if startErr = cmd.Start(); startErr != nil {
+			break
+		}
+		started++
+	}
+	// 子进程持有管道的副本,父进程必须关闭自己的副本,否则下游读不到 EOF
+	closeFiles()
+	if startErr != nil {
+		cancel(startErr)
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(cmds))
+	for i := 0; i < started; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = cmds[i].Wait()
+		}(i)
+	}
+	wg.Wait()
+	if startErr != nil {
+		return nil, startErr
+	}
+	if cause := context.Cause(ctx); cause != nil {
+		if errors.Is(cause, context.DeadlineExceeded) {
+			cause = ErrPipelineTimeout
+		}
+		return nil, fmt.Errorf("%w: %s", cause, p.stderr(stderrs))
+	}
+	// 和 shell 一样,管道的结果由最后一个命令决定
+	if err := errs[len(errs)-1]; err != nil {
+		return nil, fmt.Errorf("%w: %s", err, p.stderr(stderrs))
+	}
+	return stdout.Bytes(), nil
+}
+
+func (p Pipeline) stderr(stderrs []*limitedBuffer) string {
+	msgs := make([]string, 0, len(stderrs))
+	for i, stderr := range stderrs {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			msgs = append(msgs, p.Stages[i][0]+": "+msg)
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// 超出 limit 的内容会被丢弃,limit 为 0 表示不限制
+type limitedBuffer struct {
+	mux      sync.Mutex
+	buf      bytes.Buffer
+	limit    int
+	onExceed func()
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if b.limit > 0 && b.buf.Len()+len(p) > b.limit {
+		b.buf.Write(p[:b.limit-b.buf.Len()])
+		if b.onExceed != nil {
+			b.onExceed()
+		}
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) Bytes() []byte {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.buf.Bytes()
+}
+
+func (b *limitedBuffer) String() string {
+	return string(b.Bytes())
+}
@@ -0,0 +1,17 @@
+//go:build !windows
+
+package pkg
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// 每个命令使用单独的进程组,取消时可以连同其子进程一起杀死
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,116 @@
+package pkg
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"html"
+	"html/template"
+	"regexp"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// === password protect ===
+
+// 加密后的博客页面由浏览器使用 WebCrypto 解密,因此静态生成的页面也可以受密码保护
+const PROTECT_ITERATIONS = 100000
+
+var protectTemplate = template.Must(template.New("protect").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>{{.Title}}</title>
+</head>
+<body>
+    <div id="eb-protected" data-salt="{{.Salt}}" data-iv="{{.Iv}}" data-iter="{{.Iter}}" data-cipher="{{.Cipher}}">
+        <p>this blog is protected, please enter the password</p>
+        <form onsubmit="ebUnlock(); return false;">
+            <input id="eb-password" type="password" autofocus>
+            <button type="submit">unlock</button>
+        </form>
+        <p id="eb-error" style="color: red"></p>
+    </div>
+    <script>
+        async function ebUnlock() {
+            const el = document.getElementById("eb-protected");
+            const b64 = s => Uint8Array.from(atob(s), c => c.charCodeAt(0));
+            const password = document.getElementById("eb-password").value;
+            try {
+                const raw = await crypto.subtle.importKey("raw", new TextEncoder().encode(password), "PBKDF2", false, ["deriveKey"]);
+                const key = await crypto.subtle.deriveKey(
+                    { name: "PBKDF2", salt: b64(el.dataset.salt), iterations: Number(el.dataset.iter), hash: "SHA-256" },
+                    raw, { name: "AES-GCM", length: 256 }, false, ["decrypt"]);
+                const plain = await crypto.subtle.decrypt({ name: "AES-GCM", iv: b64(el.dataset.iv) }, key, b64(el.dataset.cipher));
+                document.open();
+                document.write(new TextDecoder().decode(plain));
+                document.close();
+            } catch (e) {
+                document.getElementById("eb-error").textContent = "wrong password";
+            }
+        }
+    </script>
+</body>
+</html>
+`))
+
+var protectDataRe = regexp.MustCompile(`data-salt="([^"]*)" data-iv="([^"]*)" data-iter="\d+" data-cipher="([^"]*)"`)
+
+// 使用 password 加密 html,返回一个可以在浏览器中解锁的页面
+func ProtectHtml(html []byte, title, password string) ([]byte, error) {
+	salt := make([]byte, 16)
+	iv := make([]byte, 12)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	gcm, err := protectCipher(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	var page bytes.Buffer
+	err = protectTemplate.Execute(&page, map[string]any{
+		"Title":  title,
+		"Salt":   base64.StdEncoding.EncodeToString(salt),
+		"Iv":     base64.StdEncoding.EncodeToString(iv),
+		"Iter":   PROTECT_ITERATIONS,
+		"Cipher": base64.StdEncoding.EncodeToString(gcm.Seal(nil, iv, html, nil)),
+	})
+	return page.Bytes(), err
+}
+
+// ProtectHtml 的逆过程
+func UnprotectHtml(page []byte, password string) ([]byte, error) {
+	match := protectDataRe.FindSubmatch(page)
+	if match == nil {
+		return nil, errors.New("not a protected page")
+	}
+	var data [3][]byte
+	for i := range data {
+		bs, err := base64.StdEncoding.DecodeString(html.UnescapeString(string(match[i+1])))
+		if err != nil {
+			return nil, err
+		}
+		data[i] = bs
+	}
+	gcm, err := protectCipher(password, data[0])
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, data[1], data[2], nil)
+}
+
+func protectCipher(password string, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(password), salt, PROTECT_ITERATIONS, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
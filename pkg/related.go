@@ -0,0 +1,261 @@
+package pkg
+
+import (
+	"math"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+)
+
+// === related posts ===
+
+// 共同标签的权重,正文的 tf-idf 余弦相似度最大为 1
+const RELATED_TAG_WEIGHT = 1.0
+
+// 根据共同的标签和正文的 tf-idf 相似度推荐相关的博客
+type RelatedIndex interface {
+	// 读取所有 md 文件
+	Build(paths []string)
+	// 文件变化后重新读取,返回推荐列表可能变化的文件
+	Update(path string) []string
+	// 文件删除后,返回推荐列表可能变化的文件
+	Remove(path string) []string
+	// 与该文件最相关的可见博客,按相关度从高到低
+	Related(path string) []LinkRef
+}
+
+type relatedDoc struct {
+	title string
+	tags  []string
+	// 词频,已经取对数
+	terms map[string]float64
+}
+
+type scoredPath struct {
+	path  string
+	score float64
+}
+
+type relatedIndexImpl struct {
+	mux        *sync.Mutex
+	blogPath   string
+	blogRouter string
+	hide       GitIgnorer
+	private    GitIgnorer
+	fsys       BlogFS
+	num        int
+	docs       map[string]*relatedDoc
+	// 包含每个词的文件数
+	df map[string]int
+	// 已经计算过的推荐列表
+	cache map[string][]scoredPath
+}
+
+// num 是每篇博客推荐的数量
+func NewRelatedIndex(blogPath, blogRouter string, hide, private GitIgnorer, num int) RelatedIndex {
+	return NewRelatedIndexFS(OsFS, blogPath, blogRouter, hide, private, num)
+}
+
+// 通过 fsys 读取文件
+func NewRelatedIndexFS(fsys BlogFS, blogPath, blogRouter string, hide, private GitIgnorer, num int) RelatedIndex {
+	return &relatedIndexImpl{
+		mux:        &sync.Mutex{},
+		blogPath:   SimplifyPath(blogPath),
+		blogRouter: blogRouter,
+		hide:       hide,
+		private:    private,
+		fsys:       fsys,
+		num:        num,
+		docs:       make(map[string]*relatedDoc),
+		df:         make(map[string]int),
+		cache:      make(map[string][]scoredPath),
+	}
+}
+
+func (r *relatedIndexImpl) Build(paths []string) {
+	for _, path := range paths {
+		if path = SimplifyPath(path); IsMdPath(path) {
+			r.Update(path)
+		}
+	}
+	log.Println("[related] build:", len(r.docs), "blogs")
+}
+
+func (r *relatedIndexImpl) Update(path string) []string {
+	path = SimplifyPath(path)
+	if !IsMdPath(path) {
+		return nil
+	}
+	content, err := r.fsys.ReadFile(path)
+	if err != nil {
+		return r.Remove(path)
+	}
+	meta, _ := MdMeta(content)
+	doc := &relatedDoc{title: meta.Title, terms: make(map[string]float64)}
+	if doc.title == "" {
+		doc.title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	for _, tag := range meta.KeyWords {
+		doc.tags = append(doc.tags, strings.ToLower(strings.TrimSpace(tag)))
+	}
+	// 受密码保护的博客只使用标签
+	if meta.Password == "" {
+		for term, count := range Terms(string(frontMatterRe.ReplaceAll(content, nil))) {
+			doc.terms[term] = 1 + math.Log(float64(count))
+		}
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.removeDoc(path)
+	r.docs[path] = doc
+	for term := range doc.terms {
+		r.df[term]++
+	}
+	// 推荐列表中有这个文件,或者这个文件可以进入推荐列表时需要重新计算
+	affected := []string{path}
+	delete(r.cache, path)
+	for other, list := range r.cache {
+		if slices.ContainsFunc(list, func(s scoredPath) bool { return s.path == path }) ||
+			len(list) < r.num || r.score(r.docs[other], doc) > list[len(list)-1].score {
+			delete(r.cache, other)
+			affected = append(affected, other)
+		}
+	}
+	return affected
+}
+
+func (r *relatedIndexImpl) Remove(path string) []string {
+	path = SimplifyPath(path)
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if _, found := r.docs[path]; !found {
+		return nil
+	}
+	r.removeDoc(path)
+	var affected []string
+	for other, list := range r.cache {
+		if slices.ContainsFunc(list, func(s scoredPath) bool { return s.path == path }) {
+			delete(r.cache, other)
+			affected = append(affected, other)
+		}
+	}
+	return affected
+}
+
+func (r *relatedIndexImpl) removeDoc(path string) {
+	old, found := r.docs[path]
+	if !found {
+		return
+	}
+	for term := range old.terms {
+		if r.df[term]--; r.df[term] <= 0 {
+			delete(r.df, term)
+		}
+	}
+	delete(r.docs, path)
+	delete(r.cache, path)
+}
+
+func (r *relatedIndexImpl) Related(path string) []LinkRef {
+	path = SimplifyPath(path)
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	doc, found := r.docs[path]
+	if !found {
+		return []LinkRef{}
+	}
+	list, cached := r.cache[path]
+	if !cached {
+		list = []scoredPath{}
+		for other, otherDoc := range r.docs {
+			if other == path || PathMatch(other, r.hide, r.private) {
+				continue
+			}
+			if score := r.score(doc, otherDoc); score > 0 {
+				list = append(list, scoredPath{other, score})
+			}
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].score != list[j].score {
+				return list[i].score > list[j].score
+			}
+			return list[i].path < list[j].path
+		})
+		list = list[:min(r.num, len(list))]
+		r.cache[path] = list
+	}
+	refs := []LinkRef{}
+	for _, s := range list {
+		// 忽略规则可能在计算之后变化
+		if !PathMatch(s.path, r.hide, r.private) {
+			refs = append(refs, LinkRef{Url: r.blogRouter + s.path[len(r.blogPath):], Title: r.docs[s.path].title})
+		}
+	}
+	return refs
+}
+
+// 标签的 jaccard 系数加上正文 tf-idf 向量的余弦相似度
+func (r *relatedIndexImpl) score(a, b *relatedDoc) float64 {
+	var score float64
+	if len(a.tags) > 0 && len(b.tags) > 0 {
+		shared := 0
+		for _, tag := range a.tags {
+			if slices.Contains(b.tags, tag) {
+				shared++
+			}
+		}
+		score += RELATED_TAG_WEIGHT * float64(shared) / float64(len(a.tags)+len(b.tags)-shared)
+	}
+	n := float64(len(r.docs))
+	idf := func(term string) float64 {
+		return math.Log(1 + n/float64(max(1, r.df[term])))
+	}
+	var dot, normA, normB float64
+	for term, tf := range a.terms {
+		w := tf * idf(term)
+		normA += w * w
+		if otherTf, found := b.terms[term]; found {
+			dot += w * otherTf * idf(term)
+		}
+	}
+	for term, tf := range b.terms {
+		w := tf * idf(term)
+		normB += w * w
+	}
+	if dot > 0 {
+		score += dot / math.Sqrt(normA*normB)
+	}
+	return score
+}
+
+// 正文中的词和出现次数,英文按单词小写,中日韩文字每个字算一个词
+func Terms(text string) map[string]int {
+	terms := make(map[string]int)
+	var word strings.Builder
+	flush := func() {
+		// 单个字母的英文单词没有区分度
+		if len([]rune(word.String())) > 1 {
+			terms[word.String()]++
+		}
+		word.Reset()
+	}
+	for _, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			flush()
+			terms[string(r)]++
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return terms
+}
@@ -0,0 +1,132 @@
+package pkg
+
+import (
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// === path resolve ===
+
+// 符号链接的处理策略
+const (
+	// 只允许指向根目录内部的符号链接
+	SYMLINK_WITHIN = "within"
+	// 不允许路径中出现符号链接
+	SYMLINK_DENY = "deny"
+	// 允许任意符号链接
+	SYMLINK_ALLOW = "allow"
+)
+
+var ErrPathEscape = errors.New("path escapes root")
+
+// 把来自 url 的相对路径安全地解析为根目录下的文件路径,所有由 url 得到的文件路径都应该经过它
+type PathResolver interface {
+	Resolve(rel string) (string, error)
+	Root() string
+}
+
+type pathResolverImpl struct {
+	root    string
+	symlink string
+}
+
+func NewPathResolver(root, symlink string) PathResolver {
+	if symlink == "" {
+		symlink = SYMLINK_WITHIN
+	}
+	return pathResolverImpl{root: SimplifyPath(root), symlink: symlink}
+}
+
+func (r pathResolverImpl) Root() string {
+	return r.root
+}
+
+func (r pathResolverImpl) Resolve(rel string) (string, error) {
+	if strings.ContainsRune(rel, 0) {
+		return "", ErrPathEscape
+	}
+	// windows 下反斜杠也是路径分隔符
+	rel = strings.ReplaceAll(rel, "\\", "/")
+	for _, seg := range strings.Split(rel, "/") {
+		if seg == ".." || filepath.VolumeName(seg) != "" {
+			return "", ErrPathEscape
+		}
+	}
+	rel = path.Clean("/" + rel)
+	full := SimplifyPath(r.root + rel)
+	switch r.symlink {
+	case SYMLINK_ALLOW:
+		return full, nil
+	case SYMLINK_DENY:
+		cur := r.root
+		for _, seg := range strings.Split(rel[1:], "/") {
+			if seg == "" {
+				continue
+			}
+			cur = cur + "/" + seg
+			stat, err := os.Lstat(cur)
+			if err != nil {
+				break
+			}
+			if stat.Mode()&os.ModeSymlink != 0 {
+				return "", ErrPathEscape
+			}
+		}
+		return full, nil
+	default:
+		root, err := filepath.EvalSymlinks(r.root)
+		if os.IsNotExist(err) {
+			return full, nil
+		} else if err != nil {
+			return "", err
+		}
+		// 文件可能还不存在(比如生成静态文件时),检查最深的已存在的祖先
+		exist := full
+		for {
+			if _, err := os.Lstat(exist); err == nil || exist == r.root {
+				break
+			}
+			exist = SimplifyPath(filepath.Dir(exist))
+		}
+		real, err := filepath.EvalSymlinks(exist)
+		if err != nil {
+			return "", err
+		}
+		if !IsSubPath(root, real) {
+			return "", ErrPathEscape
+		}
+		return full, nil
+	}
+}
+
+// 经过符号链接后 path 在 root 中的路径,用来让隐藏和私有的规则也作用于链接的目标;
+// 不存在、无法解析或在 root 之外时返回 path 本身
+func RealPathIn(root, path string) string {
+	root, path = SimplifyPath(root), SimplifyPath(path)
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return path
+	}
+	real, err := filepath.EvalSymlinks(path)
+	if err != nil || !IsSubPath(realRoot, real) {
+		return path
+	}
+	rel, err := filepath.Rel(realRoot, real)
+	if err != nil {
+		return path
+	}
+	return SimplifyPath(root + "/" + filepath.ToSlash(rel))
+}
+
+// 判断 path 是否是 root 本身或在 root 之下
+func IsSubPath(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	return rel != ".." && !strings.HasPrefix(rel, "../")
+}
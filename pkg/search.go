@@ -2,14 +2,12 @@ package pkg
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/cncsmonster/fspider"
 	"github.com/easy-projects/easyblog/pkg/log"
@@ -29,6 +27,16 @@ type SearcherPlugin struct {
 	Command string
 	Disable bool
 	Url     string
+	// for command, url and stdio plugin, duration like "5s", default 10s
+	Timeout string
+	// for command plugin, max bytes of output, default 1MB
+	MaxOutput int
+	// for url plugin, protocol version, 0 is the legacy GET protocol
+	Version   int
+	Headers   map[string]string
+	Token     string
+	Retries   int
+	HealthUrl string
 }
 
 // searcherImpl
@@ -98,60 +106,51 @@ func NewSearcherByTitle(name, brief string, spider fspider.Spider, hideMatcher,
 func NewSearcherByPlugin(plugin SearcherPlugin, hideMatcher, privateMatcher GitIgnorer, config *Config) Searcher {
 	var f func(keyword string, num int) ([]string, error)
 	if plugin.Type == "command" {
+		stages, parseErr := ParsePipeline(plugin.Command)
+		timeout, _ := time.ParseDuration(plugin.Timeout)
+		if timeout <= 0 {
+			timeout = 10 * time.Second
+		}
+		maxOutput := plugin.MaxOutput
+		if maxOutput <= 0 {
+			maxOutput = 1 << 20
+		}
 		f = func(keyword string, num int) ([]string, error) {
-			commands := strings.Split(plugin.Command, "|")
-			BLOG_PATH := config.BLOG_PATH
-			KEY_WORD := keyword
-			NUM := fmt.Sprintf("%d", num)
+			if parseErr != nil {
+				log.Println("[search by command] bad command:", parseErr)
+				return nil, parseErr
+			}
 			var ignoress []string
 			ignoress = append(ignoress, config.HIDE_PATHS...)
 			ignoress = append(ignoress, config.PRIVATE_PATHS...)
-			IGNORE := strings.Join(ignoress, ",")
-			var lastStdout io.Reader
-			var bs []byte
-			var err error
-			for i, cmdStr := range commands {
-				cmdStr = strings.TrimSpace(cmdStr)
-				args := strings.Split(cmdStr, " ")
-				for i, arg := range args {
-					args[i] = strings.TrimSpace(arg)
-					args[i] = strings.ReplaceAll(args[i], "${BLOG_PATH}", BLOG_PATH)
-					args[i] = strings.ReplaceAll(args[i], "${KEY_WORD}", KEY_WORD)
-					args[i] = strings.ReplaceAll(args[i], "${NUM}", NUM)
-					args[i] = strings.ReplaceAll(args[i], "${IGNORE}", IGNORE)
-				}
-				log.Println("[search by command] command:", args)
-				cmd := exec.Command(args[0])
-				cmd.Args = args
-				if lastStdout != nil {
-					cmd.Stdin = lastStdout
-				}
-				if i == len(commands)-1 {
-					bs, err = cmd.Output()
-					if err != nil {
-						log.Println("[search by command] failed to exec command:", err)
-						return nil, err
-					}
-					break
-				}
-				stdout, err := cmd.StdoutPipe()
-				if err != nil {
-					log.Println("[search by command] failed to get stdout pipe:", err)
-					return nil, err
-				}
-				lastStdout = stdout
-				err = cmd.Start()
-				if err != nil {
-					log.Println("[search by command] failed to start command:", err)
-					return nil, err
-				}
+			vars := map[string]string{
+				"BLOG_PATH": config.BLOG_PATH,
+				"KEY_WORD":  keyword,
+				"NUM":       fmt.Sprintf("%d", num),
+				"IGNORE":    strings.Join(ignoress, ","),
+			}
+			// the keyword comes from user, it must never become an option of the command
+			args, err := ExpandStages(stages, vars, "KEY_WORD")
+			if err != nil {
+				log.Println("[search by command] bad command:", err)
+				return nil, err
+			}
+			env := make([]string, 0, len(vars))
+			for key, value := range vars {
+				env = append(env, "EB_"+key+"="+value)
+			}
+			log.Println("[search by command] command:", args)
+			bs, err := Pipeline{Stages: args, Env: env, Timeout: timeout, MaxOutput: maxOutput}.Run(context.Background())
+			if err != nil {
+				log.Println("[search by command] failed to exec command:", err)
+				return nil, err
 			}
 			bs = bytes.TrimSpace(bs)
 			bss := bytes.Split(bs, []byte("\n"))
 			results := make([]string, 0, len(bss))
 			for _, bs := range bss {
 				path := string(bs)
-				if PathMatch(path, hideMatcher, privateMatcher) {
+				if path == "" || PathMatch(path, hideMatcher, privateMatcher) {
 					continue
 				}
 				results = append(results, path)
@@ -159,24 +158,9 @@ func NewSearcherByPlugin(plugin SearcherPlugin, hideMatcher, privateMatcher GitI
 			return results, nil
 		}
 	} else if plugin.Type == "url" {
-		f = func(keyword string, num int) ([]string, error) {
-			// put a get request to url
-			resp, err := http.Get(fmt.Sprintf("%s?keyword=%s&num=%d", plugin.Url, keyword, num))
-			if err != nil {
-				return nil, err
-			}
-			defer resp.Body.Close()
-			bs, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, err
-			}
-			// ["/path1", "/path2", ...]
-			var results []string
-			if err := json.Unmarshal(bs, &results); err != nil {
-				return nil, err
-			}
-			return results, nil
-		}
+		return newHttpSearcher(plugin, hideMatcher, privateMatcher, config)
+	} else if plugin.Type == "stdio" {
+		return newStdioSearcher(plugin, hideMatcher, privateMatcher, config)
 	} else {
 		panic("unknown plugin type")
 	}
@@ -257,7 +241,7 @@ func NewSearchByContentMatch(name, brief string, spider fspider.Spider, cache Ca
 			} else {
 				blogItem = blog.(*BlogItem)
 			}
-			num := strings.Count(blogItem.File, keyword)
+			num := strings.Count(blogItem.SearchableFile(), keyword)
 			num += strings.Count(blogItem.Meta.Title, keyword)
 			num += strings.Count(blogItem.Meta.Description, keyword)
 			items = append(items, _Item{path: path, num: num})
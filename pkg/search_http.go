@@ -0,0 +1,252 @@
+package pkg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+)
+
+// === http search plugin ===
+
+// 带有标题,摘要和分数的搜索结果
+type SearchResult struct {
+	Path    string  `json:"path"`
+	Title   string  `json:"title,omitempty"`
+	Snippet string  `json:"snippet,omitempty"`
+	Score   float64 `json:"score,omitempty"`
+}
+
+// 可以返回更丰富结果的搜索器
+type DetailSearcher interface {
+	Searcher
+	SearchDetail(keyword string, num, offset int) ([]SearchResult, error)
+}
+
+// 可以检查自身是否可用的搜索器
+type HealthChecker interface {
+	Healthy() bool
+}
+
+// http 插件协议的版本,0 为旧的 GET 协议,返回路径数组
+const HTTP_PLUGIN_VERSION = 1
+
+// version 1 请求: POST json 到插件的 url
+type HttpSearchRequest struct {
+	Version int    `json:"version"`
+	Query   string `json:"query"`
+	Num     int    `json:"num"`
+	Offset  int    `json:"offset"`
+	// 博客根目录,以及不可见路径的规则(gitignore 语法),插件应该过滤掉匹配的路径
+	BlogPath string   `json:"blog_path"`
+	Exclude  []string `json:"exclude"`
+}
+
+// version 1 响应
+type HttpSearchResponse struct {
+	Version int            `json:"version"`
+	Results []SearchResult `json:"results"`
+}
+
+type httpSearcher struct {
+	plugin      SearcherPlugin
+	client      *http.Client
+	healthUrl   string
+	hide        GitIgnorer
+	private     GitIgnorer
+	config      *Config
+	healthMux   *sync.Mutex
+	healthy     bool
+	healthCheck time.Time
+	probing     bool
+	excludeMux  *sync.RWMutex
+	// NotifyExclude 之前为 nil,使用配置中的规则
+	exclude []string
+}
+
+func newHttpSearcher(plugin SearcherPlugin, hideMatcher, privateMatcher GitIgnorer, config *Config) Searcher {
+	timeout, _ := time.ParseDuration(plugin.Timeout)
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	healthUrl := plugin.HealthUrl
+	if healthUrl == "" {
+		// 保留 url 中的参数,例如 api key
+		if u, err := url.Parse(plugin.Url); err == nil {
+			u.Path, u.RawPath = "/health", ""
+			healthUrl = u.String()
+		}
+	}
+	return &httpSearcher{
+		plugin:     plugin,
+		client:     &http.Client{Timeout: timeout},
+		healthUrl:  healthUrl,
+		hide:       hideMatcher,
+		private:    privateMatcher,
+		config:     config,
+		healthMux:  &sync.Mutex{},
+		excludeMux: &sync.RWMutex{},
+	}
+}
+
+func (s *httpSearcher) Name() string {
+	return s.plugin.Name
+}
+func (s *httpSearcher) Brief() string {
+	return s.plugin.Brief
+}
+
+func (s *httpSearcher) Search(keyword string, num int) ([]string, error) {
+	results, err := s.SearchDetail(keyword, num, 0)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(results))
+	for _, result := range results {
+		paths = append(paths, result.Path)
+	}
+	return paths, nil
+}
+
+func (s *httpSearcher) SearchDetail(keyword string, num, offset int) ([]SearchResult, error) {
+	var results []SearchResult
+	if s.plugin.Version == 0 {
+		// ["/path1", "/path2", ...],旧协议没有 offset,请求 num+offset 个结果再跳过前 offset 个
+		u, err := url.Parse(s.plugin.Url)
+		if err != nil {
+			return nil, err
+		}
+		// 插件的 url 可能已经有参数
+		query := u.Query()
+		query.Set("keyword", keyword)
+		query.Set("num", strconv.Itoa(num+offset))
+		u.RawQuery = query.Encode()
+		var paths []string
+		if err := s.do(http.MethodGet, u.String(), nil, &paths); err != nil {
+			return nil, err
+		}
+		for _, path := range paths[min(offset, len(paths)):] {
+			results = append(results, SearchResult{Path: path})
+		}
+	} else {
+		s.excludeMux.RLock()
+		exclude := s.exclude
+		s.excludeMux.RUnlock()
+		s.config.RLock()
+		if exclude == nil {
+			exclude = append(append([]string{}, s.config.HIDE_PATHS...), s.config.PRIVATE_PATHS...)
+		}
+		req := HttpSearchRequest{
+			Version:  HTTP_PLUGIN_VERSION,
+			Query:    keyword,
+			Num:      num,
+			Offset:   offset,
+			BlogPath: s.config.BLOG_PATH,
+			Exclude:  exclude,
+		}
+		s.config.RUnlock()
+		body, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		var resp HttpSearchResponse
+		if err := s.do(http.MethodPost, s.plugin.Url, body, &resp); err != nil {
+			return nil, err
+		}
+		if resp.Version != HTTP_PLUGIN_VERSION {
+			return nil, fmt.Errorf("unsupported plugin protocol version: %d", resp.Version)
+		}
+		results = resp.Results
+	}
+	// 不信任插件的过滤
+	return FilterSlice(results, func(result SearchResult) bool {
+		return result.Path != "" && !PathMatch(result.Path, s.hide, s.private)
+	}), nil
+}
+
+func (s *httpSearcher) NotifyExclude(exclude []string) {
+	s.excludeMux.Lock()
+	s.exclude = exclude
+	s.excludeMux.Unlock()
+}
+
+// 发送请求并解析 json 响应,网络错误和 5xx 会重试
+func (s *httpSearcher) do(method, url string, body []byte, v any) error {
+	var err error
+	for i := 0; i <= s.plugin.Retries; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(i) * 100 * time.Millisecond)
+			log.Println("[search by url] retry:", i, err)
+		}
+		var bs []byte
+		var status int
+		bs, status, err = s.request(method, url, body)
+		if err != nil || status >= 500 {
+			if err == nil {
+				err = fmt.Errorf("plugin responded %d: %s", status, bytes.TrimSpace(bs))
+			}
+			continue
+		}
+		if status != http.StatusOK {
+			return fmt.Errorf("plugin responded %d: %s", status, bytes.TrimSpace(bs))
+		}
+		return json.Unmarshal(bs, v)
+	}
+	return err
+}
+
+func (s *httpSearcher) request(method, url string, body []byte) ([]byte, int, error) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range s.plugin.Headers {
+		req.Header.Set(key, value)
+	}
+	if s.plugin.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.plugin.Token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	bs, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	return bs, resp.StatusCode, err
+}
+
+// 探测插件的 /health,结果缓存一段时间,避免每次列出搜索器都发送请求
+func (s *httpSearcher) Healthy() bool {
+	if s.plugin.Version == 0 && s.plugin.HealthUrl == "" {
+		// 旧协议没有 /health
+		return true
+	}
+	// 探测时不持有锁,其他调用者在探测期间使用上一次的结果
+	s.healthMux.Lock()
+	if s.probing || time.Since(s.healthCheck) < 30*time.Second {
+		healthy := s.healthy
+		s.healthMux.Unlock()
+		return healthy
+	}
+	s.probing = true
+	s.healthMux.Unlock()
+	_, status, err := s.request(http.MethodGet, s.healthUrl, nil)
+	healthy := err == nil && status == http.StatusOK
+	if !healthy {
+		log.Println("[search by url] unhealthy:", s.plugin.Name, status, err)
+	}
+	s.healthMux.Lock()
+	s.healthy, s.healthCheck, s.probing = healthy, time.Now(), false
+	s.healthMux.Unlock()
+	return healthy
+}
@@ -0,0 +1,403 @@
+package pkg
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg/log"
+	"github.com/google/shlex"
+)
+
+// === stdio search plugin ===
+
+// 常驻的插件进程,通过 stdin/stdout 以换行分隔的 json-rpc 2.0 通信:
+//
+//	-> {"jsonrpc":"2.0","id":1,"method":"init","params":{"blog_path":"blog","exclude":["*.js"]}}
+//	-> {"jsonrpc":"2.0","id":2,"method":"search","params":{"query":"go","num":10,"offset":0}}
+//	<- {"jsonrpc":"2.0","id":2,"result":{"results":[{"path":"blog/go.md","title":"Go","score":1}]}}
+//	-> {"jsonrpc":"2.0","method":"file_changed","params":{"path":"blog/go.md"}}
+//	-> {"jsonrpc":"2.0","method":"exclude_changed","params":{"exclude":["*.js","/secret"]}}
+//	-> {"jsonrpc":"2.0","method":"shutdown"}
+type rpcRequest struct {
+	JsonRpc string `json:"jsonrpc"`
+	ID      int64  `json:"id,omitempty"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// 能够接收文件变化通知的搜索器
+type ChangeNotifier interface {
+	NotifyChanged(path string)
+}
+
+// 能够接收不可见路径规则变化的搜索器,exclude 是完整的新规则
+type ExcludeNotifier interface {
+	NotifyExclude(exclude []string)
+}
+
+var ErrPluginNotRunning = errors.New("plugin not running")
+
+type stdioSearcher struct {
+	plugin  SearcherPlugin
+	args    []string
+	timeout time.Duration
+	hide    GitIgnorer
+	private GitIgnorer
+	config  *Config
+
+	mux    *sync.Mutex
+	proc   *stdioProcess
+	closed bool
+	// NotifyExclude 之前为 nil,使用配置中的规则
+	exclude []string
+	stop    chan struct{}
+	exited  chan struct{}
+}
+
+type stdioProcess struct {
+	cmd        *exec.Cmd
+	stdin      io.WriteCloser
+	writeMux   *sync.Mutex
+	pendingMux *sync.Mutex
+	pending    map[int64]chan rpcResponse
+	nextID     int64
+	// 写入 stdin 的超时时间,超时后杀死进程
+	timeout time.Duration
+	// 读取 stdout 和 stderr 的 goroutine,回收进程之前必须等它们读完
+	readers *sync.WaitGroup
+	// 进程被回收后关闭
+	done chan struct{}
+}
+
+func newStdioSearcher(plugin SearcherPlugin, hideMatcher, privateMatcher GitIgnorer, config *Config) Searcher {
+	timeout, _ := time.ParseDuration(plugin.Timeout)
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	args, err := shlex.Split(plugin.Command)
+	if err == nil && len(args) == 0 {
+		err = errors.New("empty command")
+	}
+	s := &stdioSearcher{
+		plugin:  plugin,
+		args:    args,
+		timeout: timeout,
+		hide:    hideMatcher,
+		private: privateMatcher,
+		config:  config,
+		mux:     &sync.Mutex{},
+		stop:    make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+	if err != nil {
+		log.Println("[search by stdio] bad command:", plugin.Name, err)
+		close(s.exited)
+		return s
+	}
+	go s.supervise()
+	return s
+}
+
+func (s *stdioSearcher) Name() string {
+	return s.plugin.Name
+}
+func (s *stdioSearcher) Brief() string {
+	return s.plugin.Brief
+}
+
+// 启动插件进程,进程退出后按指数退避重启,直到 Close
+func (s *stdioSearcher) supervise() {
+	defer close(s.exited)
+	backoff := time.Second
+	for {
+		started := time.Now()
+		proc, err := s.start()
+		if err != nil {
+			log.Println("[search by stdio] start failed:", s.plugin.Name, err)
+		} else {
+			proc.readers.Wait()
+			err = proc.cmd.Wait()
+			close(proc.done)
+			log.Println("[search by stdio] exited:", s.plugin.Name, err)
+			s.mux.Lock()
+			s.proc = nil
+			s.mux.Unlock()
+			proc.failPending(ErrPluginNotRunning)
+		}
+		// 运行了足够久的进程认为是正常的,重置退避时间
+		if time.Since(started) > time.Minute {
+			backoff = time.Second
+		}
+		select {
+		case <-s.stop:
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < time.Minute {
+			backoff *= 2
+		}
+	}
+}
+
+func (s *stdioSearcher) start() (*stdioProcess, error) {
+	cmd := exec.Command(s.args[0], s.args[1:]...)
+	setProcessGroup(cmd)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	proc := &stdioProcess{
+		cmd:        cmd,
+		stdin:      stdin,
+		writeMux:   &sync.Mutex{},
+		pendingMux: &sync.Mutex{},
+		pending:    make(map[int64]chan rpcResponse),
+		timeout:    s.timeout,
+		readers:    &sync.WaitGroup{},
+		done:       make(chan struct{}),
+	}
+	proc.readers.Add(2)
+	go func() {
+		defer proc.readers.Done()
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			log.Println("[search by stdio]", s.plugin.Name, "stderr:", scanner.Text())
+		}
+	}()
+	go proc.readLoop(stdout)
+	s.mux.Lock()
+	exclude := s.exclude
+	s.mux.Unlock()
+	s.config.RLock()
+	if exclude == nil {
+		exclude = append(append([]string{}, s.config.HIDE_PATHS...), s.config.PRIVATE_PATHS...)
+	}
+	params := map[string]any{
+		"blog_path": s.config.BLOG_PATH,
+		"exclude":   exclude,
+	}
+	s.config.RUnlock()
+	if _, err := proc.call("init", params, s.timeout); err != nil {
+		killProcessGroup(cmd)
+		proc.stdin.Close()
+		proc.readers.Wait()
+		cmd.Wait()
+		return nil, fmt.Errorf("init: %w", err)
+	}
+	s.mux.Lock()
+	if s.closed {
+		s.mux.Unlock()
+		proc.shutdown()
+		return proc, nil
+	}
+	s.proc = proc
+	latest := s.exclude
+	s.mux.Unlock()
+	// 初始化期间规则又变了
+	if latest != nil && !slices.Equal(latest, exclude) {
+		proc.send(rpcRequest{Method: "exclude_changed", Params: map[string][]string{"exclude": latest}})
+	}
+	log.Println("[search by stdio] started:", s.plugin.Name)
+	return proc, nil
+}
+
+func (proc *stdioProcess) readLoop(stdout io.Reader) {
+	defer proc.readers.Done()
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		var resp rpcResponse
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			log.Println("[search by stdio] bad response:", err)
+			continue
+		}
+		proc.pendingMux.Lock()
+		ch, found := proc.pending[resp.ID]
+		delete(proc.pending, resp.ID)
+		proc.pendingMux.Unlock()
+		if found {
+			ch <- resp
+		}
+	}
+}
+
+func (proc *stdioProcess) send(req rpcRequest) error {
+	req.JsonRpc = "2.0"
+	bs, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	proc.writeMux.Lock()
+	defer proc.writeMux.Unlock()
+	// 插件不读取 stdin 时写入会一直阻塞,超时后杀死进程,由 supervise 重启
+	written := make(chan error, 1)
+	go func() {
+		_, err := proc.stdin.Write(append(bs, '\n'))
+		written <- err
+	}()
+	select {
+	case err := <-written:
+		return err
+	case <-time.After(proc.timeout):
+		log.Println("[search by stdio] write timeout, kill:", req.Method)
+		killProcessGroup(proc.cmd)
+		proc.stdin.Close()
+		<-written
+		return ErrPipelineTimeout
+	}
+}
+
+func (proc *stdioProcess) call(method string, params any, timeout time.Duration) (json.RawMessage, error) {
+	ch := make(chan rpcResponse, 1)
+	proc.pendingMux.Lock()
+	proc.nextID++
+	id := proc.nextID
+	proc.pending[id] = ch
+	proc.pendingMux.Unlock()
+	if err := proc.send(rpcRequest{ID: id, Method: method, Params: params}); err != nil {
+		proc.pendingMux.Lock()
+		delete(proc.pending, id)
+		proc.pendingMux.Unlock()
+		return nil, err
+	}
+	select {
+	case resp := <-ch:
+		if resp.Error != nil {
+			return nil, fmt.Errorf("plugin error %d: %s", resp.Error.Code, resp.Error.Message)
+		}
+		return resp.Result, nil
+	case <-time.After(timeout):
+		proc.pendingMux.Lock()
+		delete(proc.pending, id)
+		proc.pendingMux.Unlock()
+		return nil, ErrPipelineTimeout
+	}
+}
+
+func (proc *stdioProcess) failPending(err error) {
+	proc.pendingMux.Lock()
+	defer proc.pendingMux.Unlock()
+	for id, ch := range proc.pending {
+		ch <- rpcResponse{ID: id, Error: &struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}{Code: -1, Message: err.Error()}}
+		delete(proc.pending, id)
+	}
+}
+
+// 通知插件退出,关闭 stdin,超时后强制杀死
+func (proc *stdioProcess) shutdown() {
+	proc.send(rpcRequest{Method: "shutdown"})
+	proc.stdin.Close()
+	go func() {
+		select {
+		case <-proc.done:
+		case <-time.After(3 * time.Second):
+			killProcessGroup(proc.cmd)
+		}
+	}()
+}
+
+func (s *stdioSearcher) current() *stdioProcess {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.proc
+}
+
+func (s *stdioSearcher) Search(keyword string, num int) ([]string, error) {
+	results, err := s.SearchDetail(keyword, num, 0)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(results))
+	for _, result := range results {
+		paths = append(paths, result.Path)
+	}
+	return paths, nil
+}
+
+func (s *stdioSearcher) SearchDetail(keyword string, num, offset int) ([]SearchResult, error) {
+	proc := s.current()
+	if proc == nil {
+		return nil, ErrPluginNotRunning
+	}
+	raw, err := proc.call("search", map[string]any{"query": keyword, "num": num, "offset": offset}, s.timeout)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Results []SearchResult `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	return FilterSlice(result.Results, func(result SearchResult) bool {
+		return result.Path != "" && !PathMatch(result.Path, s.hide, s.private)
+	}), nil
+}
+
+func (s *stdioSearcher) NotifyChanged(path string) {
+	if proc := s.current(); proc != nil {
+		proc.send(rpcRequest{Method: "file_changed", Params: map[string]string{"path": path}})
+	}
+}
+
+func (s *stdioSearcher) NotifyExclude(exclude []string) {
+	s.mux.Lock()
+	s.exclude = exclude
+	proc := s.proc
+	s.mux.Unlock()
+	if proc != nil {
+		proc.send(rpcRequest{Method: "exclude_changed", Params: map[string][]string{"exclude": exclude}})
+	}
+}
+
+func (s *stdioSearcher) Healthy() bool {
+	return s.current() != nil
+}
+
+// 停止插件进程,不再重启
+func (s *stdioSearcher) Close() error {
+	s.mux.Lock()
+	if s.closed {
+		s.mux.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.stop)
+	proc := s.proc
+	s.mux.Unlock()
+	if proc != nil {
+		proc.shutdown()
+	}
+	<-s.exited
+	return nil
+}
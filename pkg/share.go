@@ -0,0 +1,172 @@
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	fsutil "github.com/cncsmonster/gofsutil"
+)
+
+// === share links ===
+
+// 分享链接用于把一篇私有博客临时分享给没有权限的人
+type ShareLinker interface {
+	// 为 url 生成一个签名的分享令牌,ttl 为有效期,maxViews 为最大访问次数(0 表示不限)
+	Create(url string, ttl time.Duration, maxViews int) (*ShareLink, error)
+	// 校验 url 上携带的分享令牌,校验通过会消耗一次访问次数
+	Verify(url, token string) bool
+	// 列出所有仍然有效的分享链接
+	List() ([]*ShareLink, error)
+	// 轮换签名密钥,之前生成的所有分享链接都会失效
+	Rotate() error
+}
+
+type ShareLink struct {
+	ID       string    `json:"id"`
+	Url      string    `json:"url"`
+	Token    string    `json:"token"`
+	Expires  time.Time `json:"expires"`
+	MaxViews int       `json:"max_views"`
+	Views    int       `json:"views"`
+}
+
+// 分享链接带上令牌后的完整地址
+func (link *ShareLink) String() string {
+	return link.Url + "?share=" + link.Token
+}
+
+func (link *ShareLink) expired(now time.Time) bool {
+	return now.After(link.Expires) || (link.MaxViews > 0 && link.Views >= link.MaxViews)
+}
+
+type shareState struct {
+	Key   string       `json:"key"`
+	Links []*ShareLink `json:"links"`
+}
+
+// 状态保存在文件中,这样 eb share 命令和正在运行的服务可以共享同一份数据
+type shareLinkerImpl struct {
+	mux  *sync.Mutex
+	file string
+}
+
+func NewShareLinker(appDataPath string) ShareLinker {
+	return &shareLinkerImpl{mux: &sync.Mutex{}, file: SimplifyPath(appDataPath + "/share.json")}
+}
+
+func (s *shareLinkerImpl) load() (*shareState, error) {
+	var state shareState
+	bs, err := os.ReadFile(s.file)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(bs, &state); err != nil {
+			return nil, err
+		}
+	}
+	if state.Key == "" {
+		state.Key = randomHex(32)
+	}
+	return &state, nil
+}
+
+func (s *shareLinkerImpl) save(state *shareState) error {
+	now := time.Now()
+	state.Links = FilterSlice(state.Links, func(link *ShareLink) bool {
+		return !link.expired(now)
+	})
+	bs, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsutil.MustWrite(s.file, bs)
+}
+
+func (s *shareLinkerImpl) Create(url string, ttl time.Duration, maxViews int) (*ShareLink, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	state, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	link := &ShareLink{
+		ID:       randomHex(8),
+		Url:      SimplifyPath(url),
+		Expires:  time.Now().Add(ttl).Truncate(time.Second),
+		MaxViews: maxViews,
+	}
+	link.Token = link.ID + "." + shareSign(state.Key, link)
+	state.Links = append(state.Links, link)
+	if err := s.save(state); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (s *shareLinkerImpl) Verify(url, token string) bool {
+	id, sig, found := strings.Cut(token, ".")
+	if !found {
+		return false
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	state, err := s.load()
+	if err != nil {
+		return false
+	}
+	url = SimplifyPath(url)
+	for _, link := range state.Links {
+		if link.ID != id || link.Url != url || link.expired(time.Now()) {
+			continue
+		}
+		if !hmac.Equal([]byte(sig), []byte(shareSign(state.Key, link))) {
+			return false
+		}
+		link.Views++
+		return s.save(state) == nil
+	}
+	return false
+}
+
+func (s *shareLinkerImpl) List() ([]*ShareLink, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	state, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return FilterSlice(state.Links, func(link *ShareLink) bool {
+		return !link.expired(now)
+	}), nil
+}
+
+func (s *shareLinkerImpl) Rotate() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.save(&shareState{Key: randomHex(32)})
+}
+
+func shareSign(key string, link *ShareLink) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(fmt.Sprintf("%s|%s|%d|%d", link.ID, link.Url, link.Expires.Unix(), link.MaxViews)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func randomHex(n int) string {
+	bs := make([]byte, n)
+	if _, err := rand.Read(bs); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(bs)
+}
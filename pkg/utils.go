@@ -2,6 +2,8 @@ package pkg
 
 import (
 	"bytes"
+	"fmt"
+	"mime"
 	"path/filepath"
 	"strings"
 
@@ -48,16 +50,46 @@ func FilterSlice[T any](s []T, f func(T) bool) []T {
 	return match
 }
 
+// === content type ===
+
+// 系统的 mime 表中可能缺少的常见类型
+var contentTypes = map[string]string{
+	".ico":   "image/x-icon",
+	".mp4":   "video/mp4",
+	".webm":  "video/webm",
+	".ogg":   "audio/ogg",
+	".mp3":   "audio/mpeg",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".ttf":   "font/ttf",
+	".otf":   "font/otf",
+	".txt":   "text/plain; charset=utf-8",
+}
+
+// 根据扩展名得到文件的类型,未知时返回空字符串,由调用方根据内容检测
+func ContentType(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	if t, found := contentTypes[ext]; found {
+		return t
+	}
+	return mime.TypeByExtension(ext)
+}
+
 // === path generate ===
-func GenPath(url string, config *Config) string {
-	path := config.GEN_PATH + "/" + url[len(config.BLOG_ROUTER)+1:]
-	if strings.HasSuffix(path, "/") {
-		return path + "index.html"
-	} else if strings.HasSuffix(path, ".md") {
-		return path[:len(path)-len(filepath.Ext(path))] + ".html"
+func GenPath(url string, config *Config) (string, error) {
+	config.RLock()
+	genPath, blogRouter, symlink := config.GEN_PATH, config.BLOG_ROUTER, config.SYMLINK_POLICY
+	config.RUnlock()
+	if url != blogRouter && !strings.HasPrefix(url, blogRouter+"/") {
+		return "", fmt.Errorf("url not in blog router: %s", url)
 	}
-	path = SimplifyPath(path)
-	return path
+	rel := url[len(blogRouter):]
+	if strings.HasSuffix(rel, "/") || rel == "" {
+		rel += "/index.html"
+	} else if strings.HasSuffix(rel, ".md") {
+		rel = rel[:len(rel)-len(filepath.Ext(rel))] + ".html"
+	}
+	return NewPathResolver(genPath, symlink).Resolve(rel)
 }
 
 func TransformLinks(oldhtml []byte, config *Config) []byte {
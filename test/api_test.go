@@ -0,0 +1,59 @@
+package eb
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHtmlOutline(t *testing.T) {
+	toc, links := pkg.HtmlOutline([]byte(`<h1 id="intro">Intro <em>here</em></h1><p><a href="/blog/b.md">b</a> <a href="#intro">top</a></p><h2>Next</h2>`))
+	assert.Equal(t, []pkg.TocEntry{{Level: 1, Id: "intro", Text: "Intro here"}, {Level: 2, Text: "Next"}}, toc)
+	assert.Equal(t, []string{"/blog/b.md"}, links)
+}
+
+func TestHtmlBody(t *testing.T) {
+	assert.Equal(t, "<p>hi</p>", string(pkg.HtmlBody([]byte("<html><head><title>t</title></head><body><p>hi</p></body></html>"))))
+	assert.Equal(t, "<p>hi</p>", string(pkg.HtmlBody([]byte("<p>hi</p>"))))
+}
+
+func TestBlogJson(t *testing.T) {
+	root := newResolverTree(t)
+	blog := root + "/blog"
+	assert.Nil(t, os.WriteFile(blog+"/c.md", []byte("---\ntitle: C\n---\n<h1 id=\"x\">X</h1> see <a href=\"/blog/a.md\">a</a>"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/p.md", []byte("---\ntitle: P\npassword: pw\n---\nsecret"), os.ModePerm))
+	hide := pkg.NewBlogIgnorer().AddPatterns(blog + "/outer")
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat", FragmentCommand: "cat",
+		Hide: hide, Private: pkg.NewBlogIgnorer()}
+
+	item, err := loader.LoadBlog(blog + "/c.md")
+	assert.Nil(t, err)
+	json, err := loader.BlogJson(item)
+	assert.Nil(t, err)
+	assert.Equal(t, "/blog/c.md", json.Url)
+	assert.Equal(t, "md", json.Kind)
+	assert.Equal(t, "C", json.Title)
+	assert.Equal(t, []pkg.TocEntry{{Level: 1, Id: "x", Text: "X"}}, json.Toc)
+	assert.Equal(t, []string{"/blog/a.md"}, json.Links)
+	assert.True(t, json.WordCount > 0)
+
+	item, err = loader.LoadBlog(blog + "/p.md")
+	assert.Nil(t, err)
+	json, err = loader.BlogJson(item)
+	assert.Nil(t, err)
+	assert.True(t, json.Protected)
+	assert.Equal(t, "", json.Html)
+	assert.Equal(t, 0, json.WordCount)
+
+	tree, err := loader.Tree()
+	assert.Nil(t, err)
+	var names []string
+	for _, child := range tree.Children {
+		names = append(names, child.Name)
+	}
+	assert.Equal(t, []string{"a.md", "c.md", "inner", "p.md", "sub"}, names)
+	assert.Equal(t, "b.md", tree.Children[4].Children[0].Name)
+}
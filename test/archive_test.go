@@ -0,0 +1,106 @@
+package eb
+
+import (
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseArchiveUrl(t *testing.T) {
+	for url, want := range map[string][3]any{
+		"/blog/archive/":         {0, 0, ""},
+		"/blog/archive":          {0, 0, "/blog/archive/"},
+		"/blog/archive/2024/":    {2024, 0, ""},
+		"/blog/archive/2024/05/": {2024, 5, ""},
+		"/blog/archive/2024/05":  {2024, 5, "/blog/archive/2024/05/"},
+	} {
+		year, month, redirect, ok := pkg.ParseArchiveUrl(url, "/blog")
+		assert.True(t, ok, url)
+		assert.Equal(t, want, [3]any{year, month, redirect}, url)
+	}
+	for _, url := range []string{"/blog/archived/", "/blog/archive/24/", "/blog/archive/2024/13/", "/blog/archive/2024/5/", "/blog/archive/+024/", "/blog/archive/2024/05/01/", "/blog/a.md"} {
+		_, _, _, ok := pkg.ParseArchiveUrl(url, "/blog")
+		assert.False(t, ok, url)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	blog := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(blog+"/sub", os.ModePerm))
+	files := map[string]string{
+		"/a.md":      "---\ntitle: A\ndate: 2024-05-03\n---\na",
+		"/sub/b.md":  "---\ndate: 2024-05-20\n---\nb",
+		"/c.md":      "---\ndate: 2023-12-31\n---\nc",
+		"/secret.md": "---\ndate: 2024-05-01\n---\nsecret",
+		"/mtime.md":  "no date",
+		"/x.png":     "png",
+	}
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(blog+name, []byte(content), os.ModePerm))
+	}
+	// 没有日期时使用修改时间
+	mtime := time.Date(2022, 1, 2, 0, 0, 0, 0, time.Local)
+	assert.Nil(t, os.Chtimes(blog+"/mtime.md", mtime, mtime))
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer().AddPatterns(blog + "/secret.md")}
+
+	archive, err := loader.Archive()
+	assert.Nil(t, err)
+	var urls []string
+	for _, post := range archive.Posts {
+		urls = append(urls, post.Url)
+	}
+	assert.Equal(t, []string{"/blog/sub/b.md", "/blog/a.md", "/blog/c.md", "/blog/mtime.md"}, urls)
+	assert.Equal(t, []pkg.ArchiveMonth{{Year: 2024, Month: 5, Count: 2}, {Year: 2023, Month: 12, Count: 1}, {Year: 2022, Month: 1, Count: 1}}, archive.Months())
+	assert.Len(t, archive.Filter(2024, 0), 2)
+	assert.Equal(t, "A", archive.Filter(2024, 5)[1].Title)
+	assert.Empty(t, archive.Filter(2024, 4))
+
+	assert.True(t, loader.HasArchive())
+	item, err := loader.LoadArchive(0, 0)
+	assert.Nil(t, err)
+	assert.True(t, item.IsDir())
+	assert.Contains(t, item.Html, `<td><a href="/blog/archive/2024/05/">2</a></td>`)
+	assert.Contains(t, item.Html, `<a href="/blog/archive/2023/">2023</a>`)
+	item, err = loader.LoadArchive(2024, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "Archive 2024-05", item.Title)
+	assert.Contains(t, item.Html, `<span class="eb-archive-date">2024-05-03</span> <a href="/blog/a.md">A</a>`)
+	assert.NotContains(t, item.Html, "secret")
+	_, err = loader.LoadArchive(2024, 4)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = loader.LoadArchive(1999, 0)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	// 没有博客的归档页面是 404,也不会生成文件
+	gen := filepath.ToSlash(t.TempDir())
+	config := &pkg.Config{BLOG_ROUTER: "/blog", BLOG_PATH: blog, GEN_PATH: gen}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(internal.ArchiveMiddleWare(pkg.NewCache(4), loader, config))
+	for url, code := range map[string]int{"/blog/archive/2024/05/": http.StatusOK, "/blog/archive/2024/04/": http.StatusNotFound, "/blog/archive/1999/": http.StatusNotFound} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Equal(t, code, w.Code, url)
+	}
+	_, err = os.Stat(gen + "/archive/2024/05")
+	assert.Nil(t, err)
+	_, err = os.Stat(gen + "/archive/2024/04")
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(gen + "/archive/1999")
+	assert.True(t, os.IsNotExist(err))
+
+	// 博客中自己的 archive 优先
+	assert.Nil(t, os.MkdirAll(blog+"/archive", os.ModePerm))
+	assert.False(t, loader.HasArchive())
+}
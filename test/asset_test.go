@@ -0,0 +1,58 @@
+package eb
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "image/png", pkg.ContentType("a/b.png"))
+	assert.Equal(t, "image/png", pkg.ContentType("a/b.PNG"))
+	assert.Equal(t, "video/mp4", pkg.ContentType("a/b.mp4"))
+	assert.Equal(t, "font/woff2", pkg.ContentType("a/b.woff2"))
+	// 未知的类型由内容检测
+	assert.Equal(t, "", pkg.ContentType("a/b.unknownext"))
+}
+
+func TestLoadLargeAsset(t *testing.T) {
+	root := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.WriteFile(root+"/small.bin", make([]byte, 10), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/big.bin", make([]byte, 100), os.ModePerm))
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: root, BlogRouter: "/blog", MaxCacheSize: 50}
+
+	small, err := loader.LoadBlog(root + "/small.bin")
+	assert.Nil(t, err)
+	assert.True(t, small.IsOther())
+	assert.False(t, small.Streamed)
+	assert.Equal(t, 10, len(small.Html))
+
+	big, err := loader.LoadBlog(root + "/big.bin")
+	assert.Nil(t, err)
+	assert.True(t, big.Streamed)
+	assert.Equal(t, "", big.Html)
+	assert.Equal(t, int64(100), big.Size)
+	assert.NotEmpty(t, big.ETag)
+	file, err := big.Open()
+	assert.Nil(t, err)
+	content, err := io.ReadAll(file)
+	assert.Nil(t, err)
+	assert.Len(t, content, 100)
+	assert.Nil(t, file.Close())
+	_, err = small.Open()
+	assert.NotNil(t, err)
+
+	// 不能流式读取的 BlogFS 中的大文件读入内存
+	zipLoader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: "/virtual", BlogRouter: "/blog", MaxCacheSize: 50,
+		FS: pkg.NewBlogFS(fstest.MapFS{"big.bin": {Data: make([]byte, 100)}}, "/virtual")}
+	big, err = zipLoader.LoadBlog("/virtual/big.bin")
+	assert.Nil(t, err)
+	assert.False(t, big.Streamed)
+	assert.Equal(t, 100, len(big.Html))
+}
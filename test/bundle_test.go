@@ -0,0 +1,109 @@
+package eb
+
+import (
+	"archive/zip"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundle(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	blog := dir + "/blog"
+	for name, content := range map[string]string{
+		"a.md":         "---\ntitle: A\ndate: 2024-05-01\n---\nhello bundle",
+		"sub/b.md":     "b",
+		"sub/logo.png": "png",
+		"hide.md":      "hidden",
+		"private.md":   "private",
+		"secret/c.md":  "c",
+		"sub/note.txt": "",
+		"locked.md":    "---\ntitle: Locked\npassword: hunter2\n---\ntop secret body",
+	} {
+		assert.Nil(t, os.MkdirAll(filepath.Dir(blog+"/"+name), os.ModePerm))
+		assert.Nil(t, os.WriteFile(blog+"/"+name, []byte(content), os.ModePerm))
+	}
+	exe := dir + "/eb"
+	assert.Nil(t, os.WriteFile(exe, []byte("not really an executable"), os.ModePerm))
+	bundle, err := pkg.OpenBundle(exe)
+	assert.Nil(t, err)
+	assert.Nil(t, bundle)
+
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat", DirPageSize: 1,
+		Hide:    pkg.NewBlogIgnorer().AddPatterns("hide.md", "secret/"),
+		Private: pkg.NewBlogIgnorer().AddPatterns("private.md")}
+	config := &pkg.Config{PORT: 9090, BLOG_ROUTER: "/blog", BLOG_PATH: blog, RENDER_COMMAND: "cat", SEARCH_NUM: 5}
+	output := dir + "/eb-bundle"
+	assert.Nil(t, pkg.WriteBundle(output, exe, loader, config))
+	// 不能再次打包
+	assert.NotNil(t, pkg.WriteBundle(dir+"/again", output, loader, config))
+
+	bundle, err = pkg.OpenBundle(output)
+	assert.Nil(t, err)
+	assert.NotNil(t, bundle)
+	assert.Equal(t, 9090, bundle.Config.PORT)
+	assert.Equal(t, pkg.BLOG_FS_EMBED, bundle.Config.BLOG_FS)
+	assert.Equal(t, pkg.BUNDLE_BLOG_PATH, bundle.Config.BLOG_PATH)
+	assert.Empty(t, bundle.Config.RENDER_COMMAND)
+	var names []string
+	assert.Nil(t, fs.WalkDir(bundle.Blog, ".", func(path string, d fs.DirEntry, err error) error {
+		if !d.IsDir() {
+			names = append(names, path)
+		}
+		return err
+	}))
+	assert.ElementsMatch(t, []string{"a.md", "locked.md", "sub/b.md", "sub/logo.png", "sub/note.txt"}, names)
+	assert.Len(t, bundle.Index, 3)
+	// 受保护博客的密码和原文都不在 bundle 中
+	reader, err := zip.OpenReader(output)
+	assert.Nil(t, err)
+	defer reader.Close()
+	for _, file := range reader.File {
+		content, err := fs.ReadFile(reader, file.Name)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(content), "hunter2", file.Name)
+		assert.NotContains(t, string(content), "top secret", file.Name)
+	}
+	for _, doc := range bundle.Index {
+		if doc.Path == pkg.BUNDLE_BLOG_PATH+"/a.md" {
+			assert.Equal(t, "A", doc.Title)
+			assert.Contains(t, doc.File, "hello bundle")
+		}
+	}
+
+	// 读取 bundle 时不再渲染
+	blogFS := pkg.NewBlogFS(bundle.Blog, pkg.BUNDLE_BLOG_PATH)
+	bundled := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: pkg.BUNDLE_BLOG_PATH, BlogRouter: "/blog", RenderCommand: "false",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer(), FS: blogFS,
+		Rendered: pkg.NewBlogFS(bundle.Rendered, pkg.BUNDLE_BLOG_PATH)}
+	item, err := bundled.LoadBlog(pkg.BUNDLE_BLOG_PATH + "/a.md")
+	assert.Nil(t, err)
+	assert.Equal(t, "A", item.Title)
+	assert.Contains(t, item.Html, "hello bundle")
+	item, err = bundled.LoadBlog(pkg.BUNDLE_BLOG_PATH + "/locked.md")
+	assert.Nil(t, err)
+	assert.Equal(t, "Locked", item.Title)
+	assert.True(t, item.IsProtected())
+	assert.Empty(t, item.SearchableFile())
+	item, err = bundled.LoadBlog(pkg.BUNDLE_BLOG_PATH)
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, `<a href="/blog/sub/">sub/</a>`)
+	assert.Contains(t, item.Html, `<a href="/blog/a.md">`)
+	assert.NotContains(t, item.Html, "hide.md")
+	assert.NotContains(t, item.Html, "page=")
+	// 预先渲染的目录页面不支持分页和排序
+	_, err = bundled.LoadBlogWith(pkg.BUNDLE_BLOG_PATH, pkg.DirOptions{Page: 2})
+	assert.NotNil(t, err)
+	_, err = bundled.LoadBlogWith(pkg.BUNDLE_BLOG_PATH, pkg.DirOptions{Sort: "-name"})
+	assert.NotNil(t, err)
+	item, err = bundled.LoadArchive(2024, 5)
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, `<a href="/blog/a.md">A</a>`)
+	_, err = bundled.LoadArchive(2023, 1)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
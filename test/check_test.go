@@ -0,0 +1,99 @@
+package eb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	blog := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(blog+"/img", os.ModePerm))
+	files := map[string]string{
+		"/a.md": `<h2 id="top">A</h2><a href="b.md#sec">b</a> <a href="b.md#nope">x</a> <a href="missing.md">m</a> ` +
+			`<a href="secret.md">s</a> <a href="#top">self</a> <a href="#gone">g</a> <img src="img/x.png"> ` +
+			`<a href="mailto:a@b.c">mail</a> <a href="/api/tree">api</a> <a href="hidden.md">h</a>`,
+		"/b.md":       `<h2 id="sec">B</h2><a href="/blog/a.md">a</a> <a href="` + server.URL + `/ok">ok</a> <a href="` + server.URL + `/gone">gone</a>`,
+		"/lonely.md":  "nobody links here",
+		"/secret.md":  `<a href="lonely.md">private pages do not count</a>`,
+		"/hidden.md":  "hidden",
+		"/img/x.png":  "png",
+		"/img/y.png":  "png",
+		"/index.md":   "index pages are reached from the dir",
+		"/sub/c.md":   `<a href="../b.md">b</a> <a href="../">up</a>`,
+		"/sub/_x.txt": "",
+	}
+	assert.Nil(t, os.MkdirAll(blog+"/sub", os.ModePerm))
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(blog+name, []byte(content), os.ModePerm))
+	}
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer().AddPatterns(blog + "/hidden.md"), Private: pkg.NewBlogIgnorer().AddPatterns(blog + "/secret.md")}
+
+	issues, err := pkg.NewLinkChecker(loader, nil, "").Check()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []pkg.LinkIssue{
+		{Kind: pkg.LINK_ANCHOR, Page: "/blog/a.md", Link: "b.md#nope", Detail: "no such anchor"},
+		{Kind: pkg.LINK_BROKEN, Page: "/blog/a.md", Link: "missing.md", Detail: "not found"},
+		{Kind: pkg.LINK_PRIVATE, Page: "/blog/a.md", Link: "secret.md", Detail: "public page links to a private page"},
+		{Kind: pkg.LINK_ANCHOR, Page: "/blog/a.md", Link: "#gone", Detail: "no such anchor in this page"},
+		{Kind: pkg.LINK_BROKEN, Page: "/blog/a.md", Link: "hidden.md", Detail: "hidden"},
+		{Kind: pkg.LINK_ORPHAN, Page: "/blog/lonely.md"},
+		{Kind: pkg.LINK_ORPHAN, Page: "/blog/sub/c.md"},
+	}, issues)
+	// 链接图中的孤立博客和检查的结果相同
+	graph := pkg.NewLinkGraph(blog, "/blog", loader.Hide, loader.Private)
+	var paths []string
+	for name := range files {
+		paths = append(paths, blog+name)
+	}
+	graph.Build(paths)
+	var orphans []string
+	for _, node := range graph.Graph(true).Nodes {
+		if node.Orphan {
+			orphans = append(orphans, node.Url)
+		}
+	}
+	assert.Equal(t, []string{"/blog/lonely.md", "/blog/sub/c.md"}, orphans)
+
+	// 外部链接只在提供 client 时探测
+	checker := pkg.NewLinkChecker(loader, server.Client(), "eb-check")
+	page, err := os.ReadFile(blog + "/b.md")
+	assert.Nil(t, err)
+	assert.Equal(t, []pkg.LinkIssue{
+		{Kind: pkg.LINK_EXTERNAL, Page: "/blog/b.md", Link: server.URL + "/gone", Detail: "status 404"},
+	}, checker.CheckPage(blog+"/b.md", page))
+	issues, err = checker.Check()
+	assert.Nil(t, err)
+	assert.Contains(t, issues, pkg.LinkIssue{Kind: pkg.LINK_EXTERNAL, Page: "/blog/b.md", Link: server.URL + "/gone", Detail: "status 404"})
+	assert.Len(t, issues, 8)
+}
+
+func TestCheckLinksBlogFS(t *testing.T) {
+	fsys := pkg.NewBlogFS(fstest.MapFS{
+		"a.md":     {Data: []byte(`<a href="sub/b.md#x">b</a> <a href="sub/">s</a> <a href="missing.md">m</a>`)},
+		"sub/b.md": {Data: []byte(`<h2 id="x">B</h2><a href="/blog/a.md#y">a</a>`)},
+	}, "/virtual/blog")
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: "/virtual/blog", BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer(), FS: fsys}
+	// 不在磁盘上的博客也通过 FS 检查
+	issues, err := pkg.NewLinkChecker(loader, nil, "").Check()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []pkg.LinkIssue{
+		{Kind: pkg.LINK_BROKEN, Page: "/blog/a.md", Link: "missing.md", Detail: "not found"},
+		{Kind: pkg.LINK_ANCHOR, Page: "/blog/sub/b.md", Link: "/blog/a.md#y", Detail: "no such anchor"},
+	}, issues)
+}
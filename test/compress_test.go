@@ -0,0 +1,68 @@
+package eb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	assert.Equal(t, "br", pkg.NegotiateEncoding("gzip, deflate, br"))
+	assert.Equal(t, "gzip", pkg.NegotiateEncoding("gzip, deflate"))
+	assert.Equal(t, "gzip", pkg.NegotiateEncoding("br;q=0.5, gzip"))
+	assert.Equal(t, "gzip", pkg.NegotiateEncoding("br;q=0, gzip;q=0.1"))
+	assert.Equal(t, "br", pkg.NegotiateEncoding("*"))
+	assert.Equal(t, "", pkg.NegotiateEncoding("identity"))
+	assert.Equal(t, "", pkg.NegotiateEncoding(""))
+}
+
+func TestCompressible(t *testing.T) {
+	assert.True(t, pkg.Compressible("text/html; charset=utf-8"))
+	assert.True(t, pkg.Compressible("application/json"))
+	assert.True(t, pkg.Compressible("text/css; charset=utf-8"))
+	assert.True(t, pkg.Compressible("text/javascript; charset=utf-8"))
+	assert.True(t, pkg.Compressible("image/svg+xml"))
+	assert.False(t, pkg.Compressible("text/event-stream"))
+	assert.False(t, pkg.Compressible("image/png"))
+	assert.False(t, pkg.Compressible(""))
+}
+
+func TestCompress(t *testing.T) {
+	data := bytes.Repeat([]byte("hello easyblog "), 200)
+	gz, err := pkg.Compress(data, pkg.ENCODING_GZIP)
+	assert.Nil(t, err)
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	assert.Nil(t, err)
+	plain, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, data, plain)
+
+	br, err := pkg.Compress(data, pkg.ENCODING_BROTLI)
+	assert.Nil(t, err)
+	plain, err = io.ReadAll(brotli.NewReader(bytes.NewReader(br)))
+	assert.Nil(t, err)
+	assert.Equal(t, data, plain)
+
+	_, err = pkg.Compress(data, "deflate")
+	assert.NotNil(t, err)
+
+	// 同一个博客的压缩结果只计算一次
+	root := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.WriteFile(root+"/a.css", data, os.ModePerm))
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: root, BlogRouter: "/blog"}
+	blog, err := loader.LoadBlog(root + "/a.css")
+	assert.Nil(t, err)
+	first, err := blog.Compressed(pkg.ENCODING_GZIP)
+	assert.Nil(t, err)
+	second, err := blog.Compressed(pkg.ENCODING_GZIP)
+	assert.Nil(t, err)
+	assert.Same(t, &first[0], &second[0])
+}
@@ -0,0 +1,215 @@
+package eb
+
+import (
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderDirIndex(t *testing.T) {
+	root := newResolverTree(t)
+	blog := root + "/blog"
+	assert.Nil(t, os.WriteFile(blog+"/README.md", []byte("---\ntitle: Home\n---\nwelcome"), os.ModePerm))
+	hide := pkg.NewBlogIgnorer()
+	private := pkg.NewBlogIgnorer()
+	md, err := pkg.RenderDir(blog, hide, private, "/blog", blog)
+	assert.Nil(t, err)
+	meta, err := pkg.MdMeta(md)
+	assert.Nil(t, err)
+	assert.Equal(t, "Home", meta.Title)
+	assert.Contains(t, string(md), "welcome")
+	assert.Contains(t, string(md), `<a href="/blog/a.md">a</a>`)
+	// 作为首页的文件不在列表中
+	assert.NotContains(t, string(md), `href="/blog/README.md"`)
+
+	// 首页不可见时只有列表
+	private.AddPatterns(blog + "/README.md")
+	md, err = pkg.RenderDir(blog, hide, private, "/blog", blog)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(md), "welcome")
+}
+
+func TestRenderDirMeta(t *testing.T) {
+	root := newResolverTree(t)
+	blog := root + "/blog"
+	assert.Nil(t, os.WriteFile(blog+"/c.md", []byte("c"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/"+pkg.DIR_META_FILE, []byte(strings.Join([]string{
+		"title: My Dir",
+		"description: things I wrote",
+		"sort: -name",
+		"order: [sub]",
+		"hidden: [\"inner\", \"out*\"]",
+	}, "\n")), os.ModePerm))
+	hide := pkg.NewBlogIgnorer()
+	private := pkg.NewBlogIgnorer()
+	md, err := pkg.RenderDir(blog, hide, private, "/blog", blog)
+	assert.Nil(t, err)
+	s := string(md)
+	assert.Contains(t, s, "things I wrote")
+	assert.NotContains(t, s, "inner")
+	assert.NotContains(t, s, "outer")
+	assert.NotContains(t, s, pkg.DIR_META_FILE)
+	// sub 在最前面,其余按名字倒序
+	sub, c, a := strings.Index(s, `"/blog/sub/"`), strings.Index(s, `"/blog/c.md"`), strings.Index(s, `"/blog/a.md"`)
+	assert.True(t, sub >= 0 && sub < c && c < a, s)
+
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat", Hide: hide, Private: private}
+	item, err := loader.LoadBlog(blog)
+	assert.Nil(t, err)
+	assert.Equal(t, "My Dir", item.Title)
+	assert.Equal(t, "things I wrote", item.Description)
+
+	assert.Nil(t, os.WriteFile(blog+"/"+pkg.DIR_META_FILE, []byte("sort: size"), os.ModePerm))
+	_, err = pkg.RenderDir(blog, hide, private, "/blog", blog)
+	assert.NotNil(t, err)
+}
+
+func TestListDir(t *testing.T) {
+	root := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(root+"/sub", os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/sub/x.md", []byte("x"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/sub/y.md", []byte("y"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/a.md", []byte("---\ntitle: Alpha <1>\ndate: 2024-03-01\nweight: 2\ndescription: first\n---\n"+strings.Repeat("word ", 700)), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/b.md", []byte("---\ntitle: Beta\ndate: 2024-01-01\nweight: 1\n---\n中文内容"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/c.md", []byte("---\npassword: pw\ndescription: secret\n---\nhidden"), os.ModePerm))
+	hide := pkg.NewBlogIgnorer()
+	private := pkg.NewBlogIgnorer()
+	names := func(listing pkg.DirListing) (names []string) {
+		for _, item := range listing.Items {
+			names = append(names, item.Name)
+		}
+		return
+	}
+
+	listing, err := pkg.ListDir(root, pkg.DirOptions{}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.md", "b.md", "c.md", "sub"}, names(listing))
+	a, b, c, sub := listing.Items[0], listing.Items[1], listing.Items[2], listing.Items[3]
+	assert.Equal(t, "Alpha <1>", a.Title)
+	assert.Equal(t, "first", a.Description)
+	assert.Equal(t, 3, a.ReadingTime)
+	assert.Equal(t, "2024-03-01", a.Date.Format("2006-01-02"))
+	assert.Equal(t, 1, b.ReadingTime)
+	// 受保护的博客不暴露描述和字数
+	assert.Equal(t, "", c.Description)
+	assert.Equal(t, 0, c.ReadingTime)
+	assert.True(t, sub.IsDir)
+	assert.Equal(t, "/blog/sub/", sub.Url)
+	assert.Equal(t, 2, sub.Count)
+
+	listing, err = pkg.ListDir(root, pkg.DirOptions{Sort: "-weight"}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.md", "b.md", "sub", "c.md"}, names(listing))
+	listing, err = pkg.ListDir(root, pkg.DirOptions{Sort: "weight"}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"c.md", "sub", "b.md", "a.md"}, names(listing))
+
+	listing, err = pkg.ListDir(root, pkg.DirOptions{Sort: "date", Page: 2, PageSize: 3}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, listing.Pages)
+	assert.Equal(t, 4, listing.Total)
+	assert.Len(t, listing.Items, 1)
+	// 最后一页之后的页不存在
+	_, err = pkg.ListDir(root, pkg.DirOptions{Page: 3, PageSize: 3}, hide, private, "/blog", root)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	listing, err = pkg.ListDir(root, pkg.DirOptions{Page: 3, PageSize: 3, All: true}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Len(t, listing.Items, 4)
+
+	md, err := pkg.RenderDirPage(root, pkg.DirOptions{Page: 1, PageSize: 3}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Contains(t, string(md), "Alpha &lt;1&gt;")
+	assert.Contains(t, string(md), `href="?page=2&amp;sort=name"`)
+	md, err = pkg.RenderDirPage(root, pkg.DirOptions{Page: 2, PageSize: 3}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Contains(t, string(md), "2 items")
+	assert.Contains(t, string(md), `href="?page=1&amp;sort=name"`)
+	// 生成静态页面时不分页
+	md, err = pkg.RenderDirPage(root, pkg.DirOptions{Page: 2, PageSize: 3, All: true}, hide, private, "/blog", root)
+	assert.Nil(t, err)
+	assert.Contains(t, string(md), "Alpha &lt;1&gt;")
+	assert.NotContains(t, string(md), "eb-dir-pages")
+}
+
+func TestDirCacheEvictsAncestors(t *testing.T) {
+	root := filepath.ToSlash(t.TempDir())
+	blog := root + "/blog"
+	assert.Nil(t, os.MkdirAll(blog+"/a/b", os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/a/b/c.md", []byte("c"), os.ModePerm))
+	config := pkg.ParseConfig([]byte(`blog_path = "` + blog + `"
+app_data_path = "` + root + `/data"
+render_command = "cat"
+not_gen = true
+`))
+	spider := &fakeSpider{paths: []string{blog, blog + "/a", blog + "/a/b", blog + "/a/b/c.md"}, changed: make(chan string)}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	shutdown := internal.RouteApp(r, config, spider, nil)
+	defer shutdown()
+	get := func(url string) string {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		return w.Body.String()
+	}
+	assert.Contains(t, get("/blog/a/"), "1 item")
+	// b 的子项数显示在 a 的列表中
+	assert.Nil(t, os.WriteFile(blog+"/a/b/d.md", []byte("d"), os.ModePerm))
+	spider.changed <- blog + "/a/b/d.md"
+	assert.Eventually(t, func() bool { return strings.Contains(get("/blog/a/"), "2 items") }, 3*time.Second, 50*time.Millisecond)
+}
+
+func TestWordCount(t *testing.T) {
+	assert.Equal(t, 3, pkg.WordCount("hello, world 42"))
+	assert.Equal(t, 4, pkg.WordCount("中文内容"))
+	assert.Equal(t, 4, pkg.WordCount("easy blog 很好"))
+	assert.Equal(t, 1, pkg.ReadingTime(0))
+	assert.Equal(t, 2, pkg.ReadingTime(301))
+}
+
+func TestGenDirListingNotPaginated(t *testing.T) {
+	root := filepath.ToSlash(t.TempDir())
+	blog := root + "/blog"
+	assert.Nil(t, os.MkdirAll(blog, os.ModePerm))
+	for _, name := range []string{"x.md", "y.md", "z.md"} {
+		assert.Nil(t, os.WriteFile(blog+"/"+name, []byte(name), os.ModePerm))
+	}
+	config := pkg.ParseConfig([]byte(`blog_path = "` + blog + `"
+gen_path = "` + root + `/gen"
+app_data_path = "` + root + `/data"
+render_command = "cat"
+dir_page_size = 2
+`))
+	spider := &fakeSpider{paths: []string{blog, blog + "/x.md", blog + "/y.md", blog + "/z.md"}, changed: make(chan string)}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	shutdown := internal.RouteApp(r, config, spider, nil)
+	defer shutdown()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/blog/", nil))
+	assert.Contains(t, w.Body.String(), "1 / 2")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/blog/?page=3", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/dir?path=/blog/&page=2", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/dir?path=/blog/&page=3", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	// 静态页面不能翻页,生成的列表包含所有项
+	gen, err := os.ReadFile(root + "/gen/index.html")
+	assert.Nil(t, err)
+	assert.Contains(t, string(gen), "/blog/z.html")
+	assert.NotContains(t, string(gen), "eb-dir-pages")
+}
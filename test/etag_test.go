@@ -0,0 +1,99 @@
+package eb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotModified(t *testing.T) {
+	modTime := time.Date(2024, 5, 1, 10, 0, 0, 500, time.UTC)
+	cases := []struct {
+		method  string
+		headers map[string]string
+		want    bool
+	}{
+		{http.MethodGet, map[string]string{"If-None-Match": `"abc"`}, true},
+		{http.MethodGet, map[string]string{"If-None-Match": `"x", W/"abc"`}, true},
+		{http.MethodGet, map[string]string{"If-None-Match": `"abcd"`}, false},
+		{http.MethodGet, map[string]string{"If-None-Match": "*"}, true},
+		{http.MethodHead, map[string]string{"If-None-Match": `"abc"`}, true},
+		{http.MethodPost, map[string]string{"If-None-Match": `"abc"`}, false},
+		{http.MethodGet, map[string]string{"If-Modified-Since": "Wed, 01 May 2024 10:00:00 GMT"}, true},
+		{http.MethodGet, map[string]string{"If-Modified-Since": "Wed, 01 May 2024 09:59:59 GMT"}, false},
+		{http.MethodGet, map[string]string{"If-Modified-Since": "not a date"}, false},
+		// If-None-Match 优先
+		{http.MethodGet, map[string]string{"If-None-Match": `"x"`, "If-Modified-Since": "Wed, 01 May 2024 10:00:00 GMT"}, false},
+		{http.MethodGet, nil, false},
+	}
+	for _, c := range cases {
+		req := httptest.NewRequest(c.method, "/blog/a.md", nil)
+		for k, v := range c.headers {
+			req.Header.Set(k, v)
+		}
+		assert.Equal(t, c.want, internal.NotModified(req, `"abc"`, modTime), "%s %v", c.method, c.headers)
+	}
+}
+
+func TestServeBlogConditional(t *testing.T) {
+	modTime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	md := &pkg.BlogItem{Path: "/blog/a.md", Kind: pkg.BLOG_ITEM_KIND_MD, Html: "<p>a</p>", ETag: pkg.ETag([]byte("<p>a</p>")), ModTime: modTime}
+	asset := &pkg.BlogItem{Path: "/blog/a.txt", Kind: pkg.BLOG_ITEM_KIND_OTHER, Html: "text", ETag: pkg.ETag([]byte("text")), ModTime: modTime, Size: 4}
+	config := &pkg.Config{CACHE_CONTROL_MD: "no-cache", CACHE_CONTROL_ASSET: "public, max-age=60", NO_COMPRESS: true}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	for _, route := range []struct {
+		url  string
+		flag string
+		blog *pkg.BlogItem
+	}{{"/md", "", md}, {"/asset", "", asset}, {"/shared/md", "shared", md}, {"/preview/md", "preview", md}, {"/shared/asset", "shared", asset}} {
+		route := route
+		r.GET(route.url, func(c *gin.Context) {
+			if route.flag != "" {
+				c.Set(route.flag, true)
+			}
+			internal.ServeBlog(c, route.blog, config)
+		})
+	}
+	get := func(url string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/md", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, md.ETag, w.Header().Get("ETag"))
+	assert.Equal(t, "Wed, 01 May 2024 10:00:00 GMT", w.Header().Get("Last-Modified"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "<p>a</p>", w.Body.String())
+	for _, headers := range []map[string]string{{"If-None-Match": md.ETag}, {"If-None-Match": "*"}, {"If-Modified-Since": "Wed, 01 May 2024 10:00:00 GMT"}} {
+		w = get("/md", headers)
+		assert.Equal(t, http.StatusNotModified, w.Code, headers)
+		assert.Empty(t, w.Body.String())
+	}
+	w = get("/md", map[string]string{"If-None-Match": `"other"`})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = get("/asset", map[string]string{"If-None-Match": asset.ETag})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	w = get("/asset", nil)
+	assert.Equal(t, "public, max-age=60", w.Header().Get("Cache-Control"))
+
+	// 分享和预览的页面不能被共享的缓存保存
+	for _, url := range []string{"/shared/md", "/preview/md", "/shared/asset"} {
+		w = get(url, nil)
+		assert.Equal(t, http.StatusOK, w.Code, url)
+		assert.Equal(t, "private, no-store", w.Header().Get("Cache-Control"), url)
+	}
+}
@@ -0,0 +1,79 @@
+package eb
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// 只用于测试的 spider,手动发送文件变化
+type fakeSpider struct {
+	paths   []string
+	changed chan string
+}
+
+func (s *fakeSpider) Spide(path string) error     { return nil }
+func (s *fakeSpider) UnSpide(path string) error   { return nil }
+func (s *fakeSpider) FilesChanged() <-chan string { return s.changed }
+func (s *fakeSpider) AllPaths() []string          { return s.paths }
+func (s *fakeSpider) AllFiles() []string          { return s.paths }
+func (s *fakeSpider) AllDirs() []string           { return nil }
+func (s *fakeSpider) Stop()                       { close(s.changed) }
+
+func sortEvents(events []pkg.FileEvent) []pkg.FileEvent {
+	sort.Slice(events, func(i, j int) bool { return events[i].Path < events[j].Path })
+	return events
+}
+
+func TestEventBus(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	for _, name := range []string{"a.md", "b.md", "c.md"} {
+		assert.Nil(t, os.WriteFile(dir+"/"+name, []byte(name), os.ModePerm))
+	}
+	spider := &fakeSpider{paths: []string{dir + "/a.md", dir + "/b.md", dir + "/c.md"}, changed: make(chan string)}
+	bus := pkg.NewEventBus(spider, 50*time.Millisecond)
+	sub1, sub2 := bus.Subscribe("1"), bus.Subscribe("2")
+
+	assert.Nil(t, os.WriteFile(dir+"/a.md", []byte("changed"), os.ModePerm))
+	assert.Nil(t, os.Remove(dir+"/b.md"))
+	assert.Nil(t, os.Rename(dir+"/c.md", dir+"/d.md"))
+	assert.Nil(t, os.WriteFile(dir+"/e.md", []byte("e"), os.ModePerm))
+	for _, path := range []string{"a.md", "a.md", "b.md", "c.md", "d.md", "e.md"} {
+		spider.changed <- dir + "/" + path
+	}
+	expected := []pkg.FileEvent{
+		{Kind: pkg.FILE_MODIFIED, Path: dir + "/a.md"},
+		{Kind: pkg.FILE_DELETED, Path: dir + "/b.md"},
+		{Kind: pkg.FILE_RENAMED, Path: dir + "/d.md", OldPath: dir + "/c.md"},
+		{Kind: pkg.FILE_CREATED, Path: dir + "/e.md"},
+	}
+	// every subscriber receives the whole batch
+	assert.Equal(t, expected, sortEvents(<-sub1))
+	assert.Equal(t, expected, sortEvents(<-sub2))
+
+	bus.Close()
+	_, ok := <-sub1
+	assert.False(t, ok)
+}
+
+func TestEventBusAbandonedSubscriber(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	spider := &fakeSpider{changed: make(chan string)}
+	bus := pkg.NewEventBus(spider, 10*time.Millisecond)
+	sub := bus.Subscribe("abandoned")
+	for _, name := range []string{"a.md", "b.md"} {
+		assert.Nil(t, os.WriteFile(dir+"/"+name, []byte(name), os.ModePerm))
+		spider.changed <- dir + "/" + name
+		time.Sleep(50 * time.Millisecond)
+	}
+	// 订阅者不再读取,关闭后剩下的事件被丢弃,channel 仍然会被关闭
+	bus.Close()
+	time.Sleep(1500 * time.Millisecond)
+	_, ok := <-sub
+	assert.False(t, ok)
+}
@@ -0,0 +1,206 @@
+package eb
+
+import (
+	"archive/zip"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitFS(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	repo := filepath.ToSlash(t.TempDir())
+	blog := repo + "/blog"
+	assert.Nil(t, os.MkdirAll(blog+"/sub", os.ModePerm))
+	assert.Nil(t, exec.Command("git", "init", "-q", repo).Run())
+	assert.Nil(t, os.WriteFile(blog+"/a.md", []byte("---\ntitle: Old\n---\nold"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/sub/b.md", []byte("b"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(repo+"/outside.md", []byte("outside"), os.ModePerm))
+	gitCommit(t, repo, "Alice", "2024-01-01T10:00:00Z")
+	old, err := pkg.ResolveGitRef(blog, "HEAD")
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(blog+"/a.md", []byte("---\ntitle: New\n---\nnew"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/c.md", []byte("c"), os.ModePerm))
+	gitCommit(t, repo, "Bob", "2024-02-01T10:00:00Z")
+	// 工作区的改动不影响提交中的内容
+	assert.Nil(t, os.WriteFile(blog+"/a.md", []byte("dirty"), os.ModePerm))
+
+	_, err = pkg.ResolveGitRef(blog, "--all")
+	assert.NotNil(t, err)
+	_, err = pkg.ResolveGitRef(blog, "no-such-branch")
+	assert.NotNil(t, err)
+	head, err := pkg.ResolveGitRef(blog, "HEAD~1")
+	assert.Nil(t, err)
+	assert.Equal(t, old, head)
+
+	fsys, err := pkg.NewGitFS(blog, old)
+	assert.Nil(t, err)
+	content, err := fsys.ReadFile(blog + "/a.md")
+	assert.Nil(t, err)
+	assert.Equal(t, "---\ntitle: Old\n---\nold", string(content))
+	_, err = fsys.Stat(blog + "/c.md")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+	_, err = fsys.ReadFile(repo + "/outside.md")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+	entries, err := fsys.ReadDir(blog)
+	assert.Nil(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal(t, []string{"a.md", "sub"}, names)
+	stat, err := fsys.Stat(blog + "/sub")
+	assert.Nil(t, err)
+	assert.True(t, stat.IsDir())
+
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer()}
+	preview := loader.WithFS(fsys, "/blog@main")
+	path, err := preview.Url2Path("/blog@main/a.md")
+	assert.Nil(t, err)
+	item, err := preview.LoadBlog(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "Old", item.Title)
+	item, err = preview.LoadBlog(blog)
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, `<a href="/blog@main/sub/">sub/</a>`)
+	assert.NotContains(t, item.Html, "c.md")
+	_, err = preview.LoadBlog(blog + "/c.md")
+	assert.NotNil(t, err)
+}
+
+func TestPreviewIgnoreFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	repo := filepath.ToSlash(t.TempDir())
+	blog := repo + "/blog"
+	assert.Nil(t, os.MkdirAll(blog, os.ModePerm))
+	assert.Nil(t, exec.Command("git", "init", "-q", repo).Run())
+	for name, content := range map[string]string{
+		"a.md":       "a",
+		"draft.md":   "draft",
+		"hidden.md":  "hidden",
+		"old.md":     "old",
+		".ebprivate": "draft.md",
+		".ebhide":    "hidden.md",
+	} {
+		assert.Nil(t, os.WriteFile(blog+"/"+name, []byte(content), os.ModePerm))
+	}
+	gitCommit(t, repo, "Alice", "2024-01-01T10:00:00Z")
+	// 工作区中已经没有这些忽略文件了
+	assert.Nil(t, os.Remove(blog+"/.ebprivate"))
+	assert.Nil(t, os.Remove(blog+"/.ebhide"))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer().AddPatterns("old.md")}
+	config := &pkg.Config{BLOG_ROUTER: "/blog", BLOG_PATH: blog, PREVIEW: pkg.ACCESS_PUBLIC, ADMIN_TOKEN: "secret"}
+	r.Use(internal.PreviewMiddleWare(pkg.NewCache(4), loader, config))
+	get := func(url string, admin bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		if admin {
+			req.Header.Set("Authorization", "Bearer secret")
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	assert.Equal(t, http.StatusOK, get("/blog@HEAD/a.md", false).Code)
+	// 提交中的忽略文件和工作区的规则都生效
+	assert.Equal(t, http.StatusNotFound, get("/blog@HEAD/draft.md", false).Code)
+	assert.Equal(t, http.StatusNotFound, get("/blog@HEAD/old.md", false).Code)
+	assert.Equal(t, http.StatusNotFound, get("/blog@HEAD/hidden.md", false).Code)
+	assert.Equal(t, http.StatusNotFound, get("/blog@HEAD/.ebprivate", false).Code)
+	assert.Equal(t, http.StatusOK, get("/blog@HEAD/draft.md", true).Code)
+	assert.Equal(t, http.StatusNotFound, get("/blog@HEAD/hidden.md", true).Code)
+	w := get("/blog@HEAD/", false)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "a.md")
+	assert.NotContains(t, w.Body.String(), "draft.md")
+	assert.NotContains(t, w.Body.String(), "hidden.md")
+
+	config.PREVIEW = pkg.ACCESS_ADMIN
+	assert.Equal(t, http.StatusNotFound, get("/blog@HEAD/a.md", false).Code)
+	assert.Equal(t, http.StatusOK, get("/blog@HEAD/a.md", true).Code)
+}
+
+func TestBlogFS(t *testing.T) {
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	fsys := pkg.NewBlogFS(fstest.MapFS{
+		"a.md":          {Data: []byte("---\ntitle: A\n---\nsee [[b]]"), ModTime: date},
+		"sub/b.md":      {Data: []byte("b"), ModTime: date},
+		"sub/.ebhide":   {Data: []byte("secret.md")},
+		"sub/secret.md": {Data: []byte("secret")},
+	}, "/virtual/blog")
+	_, err := fsys.Stat("/virtual/other")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	paths := pkg.NewFSSpider(fsys, "/virtual/blog").AllPaths()
+	assert.Equal(t, []string{"/virtual/blog", "/virtual/blog/a.md", "/virtual/blog/sub", "/virtual/blog/sub/.ebhide", "/virtual/blog/sub/b.md", "/virtual/blog/sub/secret.md"}, paths)
+
+	hide, private := pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer()
+	assert.Nil(t, pkg.LoadIgnoreFilesFS(fsys, "/virtual/blog", hide, private, false))
+	assert.True(t, hide.Match("/virtual/blog/sub/secret.md"))
+	links := pkg.NewLinkGraphFS(fsys, "/virtual/blog", "/blog", hide, private)
+	links.Build(paths)
+	assert.Equal(t, []pkg.LinkRef{{Url: "/blog/a.md", Title: "A"}}, links.Backlinks("/virtual/blog/sub/b.md"))
+
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: "/virtual/blog", BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: hide, Private: private, Links: links, FS: fsys}
+	item, err := loader.LoadBlog("/virtual/blog/a.md")
+	assert.Nil(t, err)
+	assert.Equal(t, "A", item.Title)
+	assert.True(t, item.ModTime.Equal(date))
+	item, err = loader.LoadBlog("/virtual/blog/sub")
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, `<a href="/blog/sub/b.md">b</a>`)
+	assert.NotContains(t, item.Html, "secret")
+	archive, err := loader.Archive()
+	assert.Nil(t, err)
+	assert.Len(t, archive.Posts, 2)
+	assert.True(t, loader.HasArchive())
+}
+
+func TestZipFS(t *testing.T) {
+	zipPath := filepath.ToSlash(t.TempDir()) + "/blog.zip"
+	file, err := os.Create(zipPath)
+	assert.Nil(t, err)
+	writer := zip.NewWriter(file)
+	for name, content := range map[string]string{"a.md": "a", "sub/b.md": "b"} {
+		w, err := writer.Create(name)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, writer.Close())
+	assert.Nil(t, file.Close())
+
+	fsys, err := pkg.NewZipFS(zipPath, "/blog")
+	assert.Nil(t, err)
+	content, err := fsys.ReadFile("/blog/sub/b.md")
+	assert.Nil(t, err)
+	assert.Equal(t, "b", string(content))
+	stat, err := fsys.Stat("/blog/sub")
+	assert.Nil(t, err)
+	assert.True(t, stat.IsDir())
+	entries, err := fsys.ReadDir("/blog")
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+}
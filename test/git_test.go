@@ -0,0 +1,85 @@
+package eb
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// 在 repo 中以 author 的身份在 date 提交所有的改动
+func gitCommit(t *testing.T, repo, author, date string) {
+	for _, args := range [][]string{{"add", "-A"}, {"commit", "-q", "-m", date}} {
+		cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME="+author, "GIT_AUTHOR_EMAIL=a@b.c", "GIT_AUTHOR_DATE="+date,
+			"GIT_COMMITTER_NAME="+author, "GIT_COMMITTER_EMAIL=a@b.c", "GIT_COMMITTER_DATE="+date)
+		out, err := cmd.CombinedOutput()
+		assert.Nil(t, err, string(out))
+	}
+}
+
+func TestGitMeta(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	repo := filepath.ToSlash(t.TempDir())
+	blog := repo + "/blog"
+	data := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(blog, os.ModePerm))
+	assert.Nil(t, pkg.NewGitMeta(blog, data))
+	assert.Nil(t, exec.Command("git", "init", "-q", repo).Run())
+
+	assert.Nil(t, os.WriteFile(blog+"/a.md", []byte("a"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(repo+"/outside.md", []byte("outside"), os.ModePerm))
+	gitCommit(t, repo, "Alice", "2024-01-01T10:00:00Z")
+	assert.Nil(t, os.WriteFile(blog+"/a.md", []byte("a2"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/b.md", []byte("b"), os.ModePerm))
+	gitCommit(t, repo, "Bob", "2024-02-01T10:00:00Z")
+
+	git := pkg.NewGitMeta(blog, data)
+	assert.NotNil(t, git)
+	info, found := git.Info(blog + "/a.md")
+	assert.True(t, found)
+	assert.True(t, info.Created.Equal(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)))
+	assert.True(t, info.Updated.Equal(time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)))
+	assert.Equal(t, []string{"Alice", "Bob"}, info.Authors)
+	_, found = git.Info(repo + "/outside.md")
+	assert.False(t, found)
+	assert.FileExists(t, data+"/"+pkg.GIT_META_FILE)
+	assert.Nil(t, git.Refresh())
+
+	// 只读取新的提交
+	assert.Nil(t, os.WriteFile(blog+"/b.md", []byte("b2"), os.ModePerm))
+	gitCommit(t, repo, "Carol", "2024-03-01T10:00:00Z")
+	assert.Equal(t, []string{blog + "/b.md"}, git.Refresh())
+	info, _ = git.Info(blog + "/b.md")
+	assert.Equal(t, []string{"Bob", "Carol"}, info.Authors)
+
+	// 从缓存中读取
+	cached := pkg.NewGitMeta(blog, data)
+	cachedInfo, found := cached.Info(blog + "/b.md")
+	assert.True(t, found)
+	assert.Equal(t, info.Authors, cachedInfo.Authors)
+	assert.True(t, info.Updated.Equal(cachedInfo.Updated))
+
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer(), Git: git}
+	item, err := loader.LoadBlog(blog + "/a.md")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Alice", "Bob"}, item.Authors)
+	assert.Equal(t, item.File, item.Html)
+	fakePandoc(t)
+	loader.RenderCommand = ""
+	item, err = loader.LoadBlog(blog + "/a.md")
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, "authors:\n    - Alice\n    - Bob\n")
+	loader.RenderCommand = "cat"
+	archive, err := loader.Archive()
+	assert.Nil(t, err)
+	assert.Equal(t, []pkg.ArchiveMonth{{Year: 2024, Month: 2, Count: 1}, {Year: 2024, Month: 1, Count: 1}}, archive.Months())
+}
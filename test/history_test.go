@@ -0,0 +1,140 @@
+package eb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	lines := pkg.Diff([]byte("a\nb\nc\nd\n"), []byte("a\nx\nc\nd\ne\n"))
+	assert.Equal(t, []pkg.DiffLine{
+		{Kind: pkg.DIFF_SAME, Text: "a"},
+		{Kind: pkg.DIFF_DELETE, Text: "b"},
+		{Kind: pkg.DIFF_INSERT, Text: "x"},
+		{Kind: pkg.DIFF_SAME, Text: "c"},
+		{Kind: pkg.DIFF_SAME, Text: "d"},
+		{Kind: pkg.DIFF_INSERT, Text: "e"},
+	}, lines)
+	// 换行符不同不算差异
+	assert.Equal(t, []pkg.DiffLine{{Kind: pkg.DIFF_SAME, Text: "a"}}, pkg.Diff([]byte("a\r\n"), []byte("a")))
+	assert.Empty(t, pkg.Diff(nil, nil))
+
+	unified := string(pkg.RenderUnifiedDiff(lines))
+	assert.Contains(t, unified, "<del>- b</del>\n<ins>+ x</ins>\n")
+	split := string(pkg.RenderSplitDiff(pkg.Diff([]byte("<a>"), []byte("b\nc"))))
+	assert.Contains(t, split, "<tr><td><pre><del>&lt;a&gt;</del></pre></td><td><pre><ins>b</ins></pre></td></tr>\n")
+	assert.Contains(t, split, "<tr><td></td><td><pre><ins>c</ins></pre></td></tr>\n")
+}
+
+func TestSnapshotRevisions(t *testing.T) {
+	blog := filepath.ToSlash(t.TempDir())
+	data := filepath.ToSlash(t.TempDir())
+	store := pkg.NewRevisionStore(blog, data)
+	path := blog + "/a.md"
+
+	assert.Nil(t, os.WriteFile(path, []byte("v1"), os.ModePerm))
+	assert.Nil(t, store.Snapshot(path))
+	// 内容没有变化时不保存
+	assert.Nil(t, store.Snapshot(path))
+	assert.Nil(t, os.WriteFile(path, []byte("v2"), os.ModePerm))
+	assert.Nil(t, store.Snapshot(path))
+	assert.NotNil(t, store.Snapshot(data+"/outside.md"))
+
+	revisions, err := store.Revisions(path)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+	content, err := store.Content(path, revisions[1].Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", string(content))
+	content, err = store.Content(path, revisions[0].Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", string(content))
+	assert.Nil(t, os.WriteFile(path, []byte("v3"), os.ModePerm))
+	content, err = store.Content(path, pkg.REVISION_CURRENT)
+	assert.Nil(t, err)
+	assert.Equal(t, "v3", string(content))
+	_, err = store.Content(path, "../a.md")
+	assert.NotNil(t, err)
+
+	page := string(pkg.RenderHistory(revisions, "/blog/a.md"))
+	assert.Contains(t, page, `<a href="/blog/a.md?diff=`+revisions[1].Id+`..`+revisions[0].Id+`">previous</a>`)
+	assert.Contains(t, page, `<a href="/blog/a.md?diff=`+revisions[1].Id+`..current">current</a>`)
+}
+
+func TestGitRevisions(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	repo := filepath.ToSlash(t.TempDir())
+	blog := repo + "/blog"
+	assert.Nil(t, os.MkdirAll(blog, os.ModePerm))
+	assert.Nil(t, exec.Command("git", "init", "-q", repo).Run())
+	path := blog + "/a.md"
+	assert.Nil(t, os.WriteFile(path, []byte("v1"), os.ModePerm))
+	gitCommit(t, repo, "Alice", "2024-01-01T10:00:00Z")
+	assert.Nil(t, os.WriteFile(path, []byte("v2"), os.ModePerm))
+	gitCommit(t, repo, "Bob", "2024-02-01T10:00:00Z")
+
+	store := pkg.NewRevisionStore(blog, t.TempDir())
+	revisions, err := store.Revisions(path)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "Bob", revisions[0].Author)
+	assert.Equal(t, "2024-01-01T10:00:00Z", revisions[1].Message)
+	content, err := store.Content(path, revisions[1].Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", string(content))
+	// 只接受提交的 hash
+	_, err = store.Content(path, "--output=x")
+	assert.NotNil(t, err)
+	_, err = store.Content(path, "HEAD")
+	assert.NotNil(t, err)
+}
+
+func TestHistoryProtectedRevision(t *testing.T) {
+	blog := filepath.ToSlash(t.TempDir())
+	store := pkg.NewRevisionStore(blog, t.TempDir())
+	path := blog + "/a.md"
+	assert.Nil(t, os.WriteFile(path, []byte("---\npassword: hunter2\n---\nsecret"), os.ModePerm))
+	assert.Nil(t, store.Snapshot(path))
+	assert.Nil(t, os.WriteFile(path, []byte("public now"), os.ModePerm))
+	assert.Nil(t, store.Snapshot(path))
+	revisions, err := store.Revisions(path)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: pkg.NewBlogIgnorer()}
+	r.Use(internal.HistoryMiddleWare(store, loader, &pkg.Config{HISTORY: pkg.ACCESS_PUBLIC, ADMIN_TOKEN: "secret"}))
+	get := func(url string, admin bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		if admin {
+			req.Header.Set("Authorization", "Bearer secret")
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	// 旧版本受保护,即使博客现在不受保护也不能公开
+	diff := "/blog/a.md?diff=" + revisions[1].Id + "..current"
+	w := get(diff, false)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.NotContains(t, w.Body.String(), "hunter2")
+	w = get(diff, true)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "secret")
+	w = get("/blog/a.md?diff="+revisions[0].Id+"..current", false)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -1,9 +1,18 @@
 package eb
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
 	ignore "github.com/sabhiram/go-gitignore"
 	"github.com/stretchr/testify/assert"
 )
@@ -36,3 +45,81 @@ func TestIgnore4(t *testing.T) {
 	ignore := ignore.CompileIgnoreLines("private")
 	assert.True(t, ignore.MatchesPath("blog/private"))
 }
+
+func TestIgnoreFileScoped(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(dir+"/notes/drafts", os.ModePerm))
+	assert.Nil(t, os.WriteFile(dir+"/notes/.ebhide", []byte("*.tmp\r\ndrafts/\r\n"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(dir+"/.ebprivate", []byte("/secret.md\n"), os.ModePerm))
+	hide, private := pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer()
+	assert.Nil(t, pkg.LoadIgnoreFiles(dir, hide, private, false))
+	assert.True(t, hide.Match(dir+"/notes/a.tmp"))
+	assert.True(t, hide.Match(dir+"/notes/drafts/a.md"))
+	assert.True(t, hide.Match(dir+"/notes/.ebhide"))
+	assert.False(t, hide.Match(dir+"/a.tmp"))
+	assert.True(t, private.Match(dir+"/secret.md"))
+	assert.False(t, private.Match(dir+"/notes/secret.md"))
+	// reload after the ignore file is removed
+	assert.Nil(t, os.Remove(dir+"/notes/.ebhide"))
+	assert.True(t, pkg.ReloadIgnoreFile(dir+"/notes/.ebhide", hide, private, false))
+	assert.False(t, hide.Match(dir+"/notes/a.tmp"))
+}
+
+func TestExcludePatterns(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	hide := pkg.NewBlogIgnorer()
+	hide.LoadContent(dir+"/notes/.ebhide", []byte("drafts"))
+	private := pkg.NewBlogIgnorer()
+	private.LoadContent(dir+"/.ebprivate", []byte("/secret.md"))
+	paths := []string{dir, dir + "/secret.md", dir + "/a.md", dir + "/notes", dir + "/notes/drafts", dir + "/notes/drafts/x.md", dir + "/drafts"}
+	// 已经排除的目录中的路径不再列出
+	assert.Equal(t, []string{"*.js", "/notes/drafts", "/secret.md"}, pkg.ExcludePatterns(paths, dir, hide, private, "*.js"))
+}
+
+func TestIgnoreReload(t *testing.T) {
+	root := filepath.ToSlash(t.TempDir())
+	blog := root + "/blog"
+	assert.Nil(t, os.MkdirAll(blog, os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/diary.md", []byte("zanzibar"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/public.md", []byte("hello"), os.ModePerm))
+	excludes := make(chan []string, 10)
+	plugin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req pkg.HttpSearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		excludes <- req.Exclude
+		json.NewEncoder(w).Encode(pkg.HttpSearchResponse{Version: pkg.HTTP_PLUGIN_VERSION})
+	}))
+	defer plugin.Close()
+	config := pkg.ParseConfig([]byte(`blog_path = "` + blog + `"
+app_data_path = "` + root + `/data"
+render_command = "cat"
+not_gen = true
+hide_paths = ["*.tmp"]
+rate_limite_second = 1000
+rate_limite_minute = 1000
+`))
+	config.SEARCH_PLUGINS = []pkg.SearcherPlugin{{Name: "remote", Type: "url", Url: plugin.URL, Version: pkg.HTTP_PLUGIN_VERSION}}
+	spider := &fakeSpider{paths: []string{blog, blog + "/diary.md", blog + "/public.md"}, changed: make(chan string)}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	shutdown := internal.RouteApp(r, config, spider, nil)
+	defer shutdown()
+	search := func(searchType, keyword string) string {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/search?searchType="+searchType+"&keyword="+keyword, nil))
+		return w.Body.String()
+	}
+	assert.Eventually(t, func() bool { return strings.Contains(search("bleve", "zanzibar"), "diary.md") }, 3*time.Second, 50*time.Millisecond)
+	assert.Contains(t, search("title", "diary"), "diary.md")
+	search("remote", "x")
+	assert.Equal(t, []string{"*.tmp"}, <-excludes)
+
+	// 新的 .ebprivate 使索引,搜索缓存和插件的规则都更新
+	assert.Nil(t, os.WriteFile(blog+"/.ebprivate", []byte("diary.md"), os.ModePerm))
+	spider.paths = append(spider.paths, blog+"/.ebprivate")
+	spider.changed <- blog + "/.ebprivate"
+	assert.Eventually(t, func() bool { return !strings.Contains(search("bleve", "zanzibar"), "diary.md") }, 3*time.Second, 50*time.Millisecond)
+	assert.NotContains(t, search("title", "diary"), "diary.md")
+	search("remote", "y")
+	assert.Equal(t, []string{"*.tmp", "/.ebprivate", "/diary.md"}, <-excludes)
+}
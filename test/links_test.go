@@ -0,0 +1,172 @@
+package eb
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLinkTree(t *testing.T) (blog string, private pkg.GitIgnorer, graph pkg.LinkGraph) {
+	blog = filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(blog+"/notes", os.ModePerm))
+	files := map[string]string{
+		"/a.md":               "---\ntitle: Alpha\n---\nsee [[Beta Note]] and [[notes/c|the c]] and [b](notes/beta-note.md#x)",
+		"/notes/beta-note.md": "---\ntitle: B\n---\nback to [[Alpha]], `[[Alpha]]` in code\n```\n[[Alpha]]\n```\n",
+		"/notes/c.md":         "[[../a.md#top]] [[missing page]] <a href=\"/blog/notes/beta-note.md\">b</a> [out](https://example.com/a.md)",
+		"/secret.md":          "[[Alpha]]",
+	}
+	var paths []string
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(blog+name, []byte(content), os.ModePerm))
+		paths = append(paths, blog+name)
+	}
+	private = pkg.NewBlogIgnorer().AddPatterns(blog + "/secret.md")
+	graph = pkg.NewLinkGraph(blog, "/blog", pkg.NewBlogIgnorer(), private)
+	graph.Build(paths)
+	return blog, private, graph
+}
+
+func TestWikiLinks(t *testing.T) {
+	blog, _, graph := newLinkTree(t)
+	path, ok := graph.Resolve("Beta Note", blog)
+	assert.True(t, ok)
+	assert.Equal(t, blog+"/notes/beta-note.md", path)
+	path, ok = graph.Resolve("alpha", blog+"/notes")
+	assert.True(t, ok)
+	assert.Equal(t, blog+"/a.md", path)
+	_, ok = graph.Resolve("Secret", blog)
+	assert.False(t, ok)
+	_, ok = graph.Resolve("../../etc/passwd", blog)
+	assert.False(t, ok)
+
+	md := string(graph.ExpandWikiLinks([]byte("see [[Beta Note]] and [[notes/c|the c]] `[[Alpha]]` [[nope]] [[Alpha#Intro]]"), blog))
+	assert.Equal(t, "see [Beta Note](/blog/notes/beta-note.md) and [the c](/blog/notes/c.md) `[[Alpha]]` "+
+		`<span class="eb-wikilink-missing">nope</span> [Alpha#Intro](/blog/a.md#Intro)`, md)
+	md = string(graph.ExpandWikiLinks([]byte("```\n[[Alpha]]\n```\n[[Alpha]]"), blog))
+	assert.Equal(t, "```\n[[Alpha]]\n```\n[Alpha](/blog/a.md)", md)
+}
+
+func TestLinkGraph(t *testing.T) {
+	blog, _, graph := newLinkTree(t)
+	assert.ElementsMatch(t, []string{blog + "/notes/beta-note.md", blog + "/notes/c.md"}, graph.Links(blog+"/a.md"))
+	assert.ElementsMatch(t, []string{blog + "/a.md", blog + "/notes/beta-note.md"}, graph.Links(blog+"/notes/c.md"))
+	// 私有的博客不出现在反向链接中
+	assert.Equal(t, []pkg.LinkRef{
+		{Url: "/blog/notes/beta-note.md", Title: "B"},
+		{Url: "/blog/notes/c.md", Title: "c"},
+	}, graph.Backlinks(blog+"/a.md"))
+
+	// 新建的文件使之前找不到的 wiki-link 可以解析
+	assert.Nil(t, os.WriteFile(blog+"/missing-page.md", []byte("new"), os.ModePerm))
+	affected := graph.Update(blog + "/missing-page.md")
+	assert.Contains(t, affected, blog+"/notes/c.md")
+	assert.Equal(t, []pkg.LinkRef{{Url: "/blog/notes/c.md", Title: "c"}}, graph.Backlinks(blog+"/missing-page.md"))
+
+	assert.Nil(t, os.WriteFile(blog+"/notes/c.md", []byte("nothing"), os.ModePerm))
+	affected = graph.Update(blog + "/notes/c.md")
+	assert.Contains(t, affected, blog+"/missing-page.md")
+	assert.Empty(t, graph.Backlinks(blog+"/missing-page.md"))
+
+	assert.Nil(t, os.Remove(blog+"/notes/beta-note.md"))
+	affected = graph.Remove(blog + "/notes/beta-note.md")
+	assert.Contains(t, affected, blog+"/a.md")
+	// 普通链接即使目标不存在也会保留
+	assert.ElementsMatch(t, []string{blog + "/notes/c.md", blog + "/notes/beta-note.md"}, graph.Links(blog+"/a.md"))
+}
+
+func TestLinkGraphVisibility(t *testing.T) {
+	blog, private, _ := newLinkTree(t)
+	assert.Nil(t, os.MkdirAll(blog+"/drafts", os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/drafts/d.md", []byte("draft"), os.ModePerm))
+	hide := pkg.NewBlogIgnorer().AddPatterns(blog + "/drafts")
+	graph := pkg.NewLinkGraph(blog, "/blog", hide, private)
+	graph.Build([]string{blog + "/a.md", blog + "/secret.md", blog + "/drafts/d.md"})
+	// 路径形式的 wiki-link 也不会解析到隐藏和私有的博客
+	for _, target := range []string{"secret.md", "/secret", "drafts/d", "/drafts/d.md"} {
+		_, ok := graph.Resolve(target, blog)
+		assert.False(t, ok, target)
+	}
+	md := string(graph.ExpandWikiLinks([]byte("[[secret.md|s]] [[drafts/d]]"), blog))
+	assert.Equal(t, `<span class="eb-wikilink-missing">s</span> <span class="eb-wikilink-missing">drafts/d</span>`, md)
+	path, ok := graph.Resolve("notes/c", blog)
+	assert.True(t, ok)
+	assert.Equal(t, blog+"/notes/c.md", path)
+}
+
+func TestLinkGraphSymlinkBacklinks(t *testing.T) {
+	blog, private, _ := newLinkTree(t)
+	if err := os.Symlink(blog+"/notes/beta-note.md", blog+"/beta.md"); err != nil {
+		t.Skip("symlink not supported:", err)
+	}
+	assert.Nil(t, os.WriteFile(blog+"/d.md", []byte("[d](beta.md)"), os.ModePerm))
+	graph := pkg.NewLinkGraph(blog, "/blog", pkg.NewBlogIgnorer(), private)
+	graph.Build([]string{blog + "/a.md", blog + "/notes/c.md", blog + "/d.md"})
+	// 通过符号链接和真实路径访问的是同一篇博客,反向链接相同
+	refs := []pkg.LinkRef{
+		{Url: "/blog/a.md", Title: "Alpha"},
+		{Url: "/blog/d.md", Title: "d"},
+		{Url: "/blog/notes/c.md", Title: "c"},
+	}
+	assert.Equal(t, refs, graph.Backlinks(blog+"/beta.md"))
+	assert.Equal(t, refs, graph.Backlinks(blog+"/notes/beta-note.md"))
+
+	assert.Nil(t, os.Remove(blog+"/d.md"))
+	graph.Remove(blog + "/d.md")
+	assert.Equal(t, []pkg.LinkRef{refs[0], refs[2]}, graph.Backlinks(blog+"/notes/beta-note.md"))
+}
+
+func TestLinkGraphJson(t *testing.T) {
+	_, _, graph := newLinkTree(t)
+	public := graph.Graph(false)
+	assert.Equal(t, []pkg.GraphNode{
+		{Url: "/blog/a.md", Title: "Alpha", In: 2, Out: 2},
+		{Url: "/blog/notes/beta-note.md", Title: "B", In: 2, Out: 1},
+		{Url: "/blog/notes/c.md", Title: "c", In: 1, Out: 2},
+	}, public.Nodes)
+	assert.Len(t, public.Edges, 5)
+	assert.NotContains(t, public.Edges, pkg.GraphEdge{Source: "/blog/secret.md", Target: "/blog/a.md"})
+
+	// 私有的博客只在请求时出现,它们不需要被链接,不是孤立的
+	all := graph.Graph(true)
+	assert.Len(t, all.Nodes, 4)
+	assert.Equal(t, pkg.GraphNode{Url: "/blog/secret.md", Title: "secret", In: 0, Out: 1}, all.Nodes[3])
+	assert.Equal(t, 3, all.Nodes[0].In)
+	assert.Contains(t, all.Edges, pkg.GraphEdge{Source: "/blog/secret.md", Target: "/blog/a.md"})
+}
+
+func TestLoadBlogWithBacklinks(t *testing.T) {
+	blog, private, graph := newLinkTree(t)
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: private, Links: graph}
+	item, err := loader.LoadBlog(blog + "/a.md")
+	assert.Nil(t, err)
+	assert.Contains(t, item.File, "[Beta Note](/blog/notes/beta-note.md)")
+	assert.NotContains(t, item.File, "backlinks")
+	// 自定义的渲染命令只收到 md
+	assert.Equal(t, item.File, item.Html)
+
+	// pandoc 通过 --metadata-file 收到 backlinks
+	fakePandoc(t)
+	loader.RenderCommand = ""
+	item, err = loader.LoadBlog(blog + "/a.md")
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, "backlinks:\n    - url: /blog/notes/beta-note.md\n      title: B\n")
+	assert.True(t, strings.HasSuffix(item.Html, item.File))
+}
+
+// 把 PATH 中的 pandoc 换成输出 --metadata-file 的内容和标准输入的脚本
+func fakePandoc(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake pandoc needs sh")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\nfor arg in \"$@\"; do\n  case \"$arg\" in --metadata-file=*) cat \"${arg#--metadata-file=}\";; esac\ndone\ncat\n"
+	assert.Nil(t, os.WriteFile(dir+"/pandoc", []byte(script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
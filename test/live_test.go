@@ -0,0 +1,95 @@
+package eb
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveReload(t *testing.T) {
+	blog := filepath.ToSlash(t.TempDir())
+	path := blog + "/中文 笔记.md"
+	assert.Nil(t, os.WriteFile(path, []byte("v1"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(blog+"/hide.md", []byte("hide"), os.ModePerm))
+	spider := &fakeSpider{paths: []string{path, blog + "/hide.md"}, changed: make(chan string)}
+	bus := pkg.NewEventBus(spider, 10*time.Millisecond)
+	defer bus.Close()
+	hide := pkg.NewBlogIgnorer().AddPatterns("hide.md")
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: hide, Private: pkg.NewBlogIgnorer()}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/live", internal.LiveReloadMiddleWare(internal.NewLiveHub(bus, hide, loader.Private), loader))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/live?path=" + url.QueryEscape("/blog/hide.md"))
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// 和页面中的脚本一样,path 只编码一次
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/live?path="+url.QueryEscape("/blog/中文 笔记.md"), nil)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	lines := bufio.NewScanner(resp.Body)
+	next := func() string {
+		for lines.Scan() {
+			if strings.HasPrefix(lines.Text(), "event:") {
+				event := lines.Text()
+				lines.Scan()
+				return event + " " + lines.Text()
+			}
+		}
+		return ""
+	}
+	assert.Equal(t, "event:ready data:/blog/中文 笔记.md", next())
+	assert.Nil(t, os.WriteFile(path, []byte("v2"), os.ModePerm))
+	spider.changed <- path
+	assert.Equal(t, "event:change data:/blog/中文 笔记.md", next())
+}
+
+func TestInjectLiveReload(t *testing.T) {
+	item := &pkg.BlogItem{Kind: pkg.BLOG_ITEM_KIND_MD, Html: "<html><body>hi</body></html>"}
+	html := string(internal.InjectLiveReload(item, &pkg.Config{LIVE_RELOAD: true, API_ROUTER: "/api"}))
+	assert.Contains(t, html, `new EventSource("/api/live?path=" + encodeURIComponent(path))`)
+	assert.Contains(t, html, "decodeURIComponent(path)")
+	assert.True(t, strings.HasSuffix(html, "</script>\n</body></html>"))
+	assert.Equal(t, item.Html, string(internal.InjectLiveReload(item, &pkg.Config{API_ROUTER: "/api"})))
+
+	// 预览和分享的页面不能被 /api/live 订阅,不插入脚本
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	config := &pkg.Config{LIVE_RELOAD: true, API_ROUTER: "/api"}
+	for _, key := range []string{"", "preview", "shared"} {
+		key := key
+		r.GET("/"+key, func(c *gin.Context) {
+			if key != "" {
+				c.Set(key, true)
+			}
+			internal.ServeBlog(c, item, config)
+		})
+	}
+	for url, injected := range map[string]bool{"/": true, "/preview": false, "/shared": false} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Equal(t, injected, strings.Contains(w.Body.String(), "EventSource"), url)
+	}
+}
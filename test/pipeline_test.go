@@ -0,0 +1,76 @@
+//go:build !windows
+
+package eb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePipeline(t *testing.T) {
+	stages, err := pkg.ParsePipeline(`fd . ${BLOG_PATH} | rg -- "a | b" | head -n ${NUM}`)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"fd", ".", "${BLOG_PATH}"}, {"rg", "--", "a | b"}, {"head", "-n", "${NUM}"}}, stages)
+	_, err = pkg.ParsePipeline(`rg "a | b`)
+	assert.NotNil(t, err)
+	_, err = pkg.ParsePipeline(`rg a || head`)
+	assert.NotNil(t, err)
+}
+
+func TestExpandStagesGuarded(t *testing.T) {
+	stages, _ := pkg.ParsePipeline(`rg -l -- ${KEY_WORD} ${BLOG_PATH}`)
+	args, err := pkg.ExpandStages(stages, map[string]string{"KEY_WORD": "--pre=sh x", "BLOG_PATH": "blog"}, "KEY_WORD")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"rg", "-l", "--", "--pre=sh x", "blog"}}, args)
+	stages, _ = pkg.ParsePipeline(`rg ${KEY_WORD} ${BLOG_PATH}`)
+	_, err = pkg.ExpandStages(stages, map[string]string{"KEY_WORD": "--pre=sh"}, "KEY_WORD")
+	assert.NotNil(t, err)
+	stages, _ = pkg.ParsePipeline(`fzf --filter=${KEY_WORD}`)
+	args, err = pkg.ExpandStages(stages, map[string]string{"KEY_WORD": "-x"}, "KEY_WORD")
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"fzf", "--filter=-x"}}, args)
+	// 变量的值不会被再次替换,与 map 的遍历顺序无关
+	stages, _ = pkg.ParsePipeline(`grep -- ${KEY_WORD} ${BLOG_PATH}`)
+	for i := 0; i < 20; i++ {
+		args, err = pkg.ExpandStages(stages, map[string]string{"KEY_WORD": "${BLOG_PATH}${NUM}", "BLOG_PATH": "blog", "NUM": "5"}, "KEY_WORD")
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"grep", "--", "${BLOG_PATH}${NUM}", "blog"}}, args)
+	}
+	// 受保护的变量不能作为命令
+	stages, _ = pkg.ParsePipeline(`${KEY_WORD} -- x`)
+	_, err = pkg.ExpandStages(stages, map[string]string{"KEY_WORD": "sh"}, "KEY_WORD")
+	assert.NotNil(t, err)
+	stages, _ = pkg.ParsePipeline(`cat x | /bin/${KEY_WORD}`)
+	_, err = pkg.ExpandStages(stages, map[string]string{"KEY_WORD": "sh"}, "KEY_WORD")
+	assert.NotNil(t, err)
+}
+
+func TestPipelineRun(t *testing.T) {
+	stages, _ := pkg.ParsePipeline(`printf 'a\nb\nc\n' | sort -r | head -n 2`)
+	out, err := pkg.Pipeline{Stages: stages}.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "c\nb\n", string(out))
+	// upstream is killed by SIGPIPE, the result depends on the last command
+	stages, _ = pkg.ParsePipeline(`yes | head -n 1`)
+	out, err = pkg.Pipeline{Stages: stages, Timeout: 5 * time.Second}.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "y\n", string(out))
+}
+
+func TestPipelineLimits(t *testing.T) {
+	stages, _ := pkg.ParsePipeline(`sh -c "sleep 10 & sleep 10" | cat`)
+	start := time.Now()
+	_, err := pkg.Pipeline{Stages: stages, Timeout: 200 * time.Millisecond}.Run(context.Background())
+	assert.ErrorIs(t, err, pkg.ErrPipelineTimeout)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	stages, _ = pkg.ParsePipeline(`yes`)
+	_, err = pkg.Pipeline{Stages: stages, MaxOutput: 1024, Timeout: 5 * time.Second}.Run(context.Background())
+	assert.ErrorIs(t, err, pkg.ErrPipelineOutput)
+	stages, _ = pkg.ParsePipeline(`sh -c "echo oops >&2; exit 3"`)
+	_, err = pkg.Pipeline{Stages: stages}.Run(context.Background())
+	assert.ErrorContains(t, err, "oops")
+}
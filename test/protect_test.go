@@ -0,0 +1,36 @@
+package eb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtectHtml(t *testing.T) {
+	html := []byte("<html><body><a href=\"/blog/a.md\">secret</a></body></html>")
+	page, err := pkg.ProtectHtml(html, "title", "passw0rd+/")
+	assert.Nil(t, err)
+	assert.NotContains(t, string(page), "secret")
+	plain, err := pkg.UnprotectHtml(page, "passw0rd+/")
+	assert.Nil(t, err)
+	assert.Equal(t, html, plain)
+	_, err = pkg.UnprotectHtml(page, "wrong")
+	assert.NotNil(t, err)
+}
+
+func TestProtectedIndex(t *testing.T) {
+	indexer := pkg.NewBlogIndexer(filepath.ToSlash(t.TempDir()) + "/blog.bleve")
+	defer indexer.Close()
+	item := &pkg.BlogItem{Path: "/blog/a.md", Meta: pkg.Meta{Title: "locked", Password: "hunter2"}, File: "secret body"}
+	assert.Nil(t, indexer.Add(item))
+	for _, keyword := range []string{"hunter2", "secret"} {
+		results, err := indexer.Search(keyword, 10)
+		assert.Nil(t, err)
+		assert.Empty(t, results, keyword)
+	}
+	results, err := indexer.Search("locked", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/blog/a.md"}, results)
+}
@@ -0,0 +1,63 @@
+package eb
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, map[string]int{"go": 2, "语": 1, "言": 1}, pkg.Terms("Go 语言, a go!"))
+}
+
+func TestRelated(t *testing.T) {
+	blog := filepath.ToSlash(t.TempDir())
+	files := map[string]string{
+		"/go1.md":    "---\ntitle: Go 1\nkeywords: [go]\n---\ngoroutines channels concurrency scheduler goroutines",
+		"/go2.md":    "---\nkeywords: [Go, web]\n---\ngoroutines channels http server",
+		"/locked.md": "---\nkeywords: [go, other]\npassword: pw\n---\ngoroutines channels concurrency scheduler",
+		"/cook.md":   "pasta tomato basil recipe",
+		"/cook2.md":  "pasta tomato garlic",
+		"/secret.md": "goroutines channels concurrency scheduler",
+	}
+	var paths []string
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(blog+name, []byte(content), os.ModePerm))
+		paths = append(paths, blog+name)
+	}
+	private := pkg.NewBlogIgnorer().AddPatterns(blog + "/secret.md")
+	related := pkg.NewRelatedIndex(blog, "/blog", pkg.NewBlogIgnorer(), private, 3)
+	related.Build(paths)
+
+	// 受密码保护的博客只按标签推荐,私有的博客不被推荐
+	assert.Equal(t, []pkg.LinkRef{{Url: "/blog/go2.md", Title: "go2"}, {Url: "/blog/locked.md", Title: "locked"}}, related.Related(blog+"/go1.md"))
+	assert.Equal(t, []pkg.LinkRef{{Url: "/blog/cook2.md", Title: "cook2"}}, related.Related(blog+"/cook.md"))
+	assert.Equal(t, []pkg.LinkRef{}, related.Related(blog+"/nope.md"))
+
+	// 新的内容可以进入已经计算过的推荐列表
+	assert.Nil(t, os.WriteFile(blog+"/cook2.md", []byte("goroutines and pasta"), os.ModePerm))
+	affected := related.Update(blog + "/cook2.md")
+	assert.Contains(t, affected, blog+"/go1.md")
+	assert.Contains(t, related.Related(blog+"/go1.md"), pkg.LinkRef{Url: "/blog/cook2.md", Title: "cook2"})
+
+	assert.Nil(t, os.Remove(blog+"/go2.md"))
+	affected = related.Remove(blog + "/go2.md")
+	assert.Contains(t, affected, blog+"/go1.md")
+	assert.NotContains(t, related.Related(blog+"/go1.md"), pkg.LinkRef{Url: "/blog/go2.md", Title: "go2"})
+
+	// 推荐通过 --metadata-file 传给 pandoc,不会出现在其他渲染命令的输出中
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", RenderCommand: "cat",
+		Hide: pkg.NewBlogIgnorer(), Private: private, Related: related}
+	item, err := loader.LoadBlog(blog + "/go1.md")
+	assert.Nil(t, err)
+	assert.Equal(t, item.File, item.Html)
+	fakePandoc(t)
+	loader.RenderCommand = ""
+	item, err = loader.LoadBlog(blog + "/go1.md")
+	assert.Nil(t, err)
+	assert.Contains(t, item.Html, "related:\n    - url: /blog/locked.md\n")
+}
@@ -0,0 +1,115 @@
+package eb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 构造一个博客目录: root/blog/{a.md, sub/b.md, inner -> sub, outer -> root/secret}
+func newResolverTree(t *testing.T) (root string) {
+	root = filepath.ToSlash(t.TempDir())
+	assert.Nil(t, os.MkdirAll(root+"/blog/sub", os.ModePerm))
+	assert.Nil(t, os.MkdirAll(root+"/secret", os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/blog/a.md", []byte("a"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/blog/sub/b.md", []byte("b"), os.ModePerm))
+	assert.Nil(t, os.WriteFile(root+"/secret/passwd", []byte("secret"), os.ModePerm))
+	assert.Nil(t, os.Symlink(root+"/blog/sub", root+"/blog/inner"))
+	assert.Nil(t, os.Symlink(root+"/secret", root+"/blog/outer"))
+	return root
+}
+
+func TestResolveMaliciousUrls(t *testing.T) {
+	root := newResolverTree(t)
+	for _, policy := range []string{pkg.SYMLINK_WITHIN, pkg.SYMLINK_DENY, pkg.SYMLINK_ALLOW} {
+		loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: root + "/blog", BlogRouter: "/blog", SymlinkPolicy: policy}
+		for _, url := range []string{
+			"/blog/../secret/passwd",
+			"/blog/..",
+			"/blog/sub/../../secret/passwd",
+			"/blog/..\\secret\\passwd",
+			"/blog/sub\\..\\..\\secret\\passwd",
+			"/blog/a.md\x00.png",
+			"/blogx/a.md",
+			"/other/a.md",
+		} {
+			path, err := loader.Url2Path(url)
+			assert.NotNil(t, err, "policy: %s, url: %q, path: %s", policy, url, path)
+		}
+	}
+}
+
+func TestResolveSymlinkPolicy(t *testing.T) {
+	root := newResolverTree(t)
+	cases := []struct {
+		policy string
+		rel    string
+		ok     bool
+	}{
+		{pkg.SYMLINK_WITHIN, "/a.md", true},
+		{pkg.SYMLINK_WITHIN, "/inner/b.md", true},
+		{pkg.SYMLINK_WITHIN, "/outer/passwd", false},
+		{pkg.SYMLINK_WITHIN, "/outer/not_exist/x.html", false},
+		{pkg.SYMLINK_WITHIN, "/not_exist/x.html", true},
+		{pkg.SYMLINK_DENY, "/a.md", true},
+		{pkg.SYMLINK_DENY, "/inner/b.md", false},
+		{pkg.SYMLINK_DENY, "/outer/passwd", false},
+		{pkg.SYMLINK_ALLOW, "/inner/b.md", true},
+		{pkg.SYMLINK_ALLOW, "/outer/passwd", true},
+	}
+	for _, c := range cases {
+		path, err := pkg.NewPathResolver(root+"/blog", c.policy).Resolve(c.rel)
+		if c.ok {
+			assert.Nil(t, err, "policy: %s, rel: %s", c.policy, c.rel)
+			assert.Equal(t, root+"/blog"+c.rel, path)
+		} else {
+			assert.ErrorIs(t, err, pkg.ErrPathEscape, "policy: %s, rel: %s", c.policy, c.rel)
+		}
+	}
+}
+
+func TestSymlinkToPrivate(t *testing.T) {
+	root := newResolverTree(t)
+	blog := root + "/blog"
+	private := pkg.NewBlogIgnorer().AddPatterns("sub/")
+	loader := &pkg.BlogLoader{RWMutex: &sync.RWMutex{}, BlogPath: blog, BlogRouter: "/blog", Private: private}
+	assert.Equal(t, blog+"/sub/b.md", pkg.RealPathIn(blog, blog+"/inner/b.md"))
+	assert.Equal(t, blog+"/outer/passwd", pkg.RealPathIn(blog, blog+"/outer/passwd"))
+	// inner 指向私有的 sub,通过链接也不能访问
+	assert.False(t, pkg.PathMatch(blog+"/inner/b.md", private))
+	assert.True(t, loader.PathMatch(blog+"/inner/b.md", private))
+	assert.False(t, loader.PathMatch(blog+"/a.md", private))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(internal.PrivateMiddleWare(private, pkg.NewShareLinker(t.TempDir()), loader))
+	r.GET("/blog/*path", func(c *gin.Context) { c.Status(http.StatusOK) })
+	for url, code := range map[string]int{"/blog/a.md": http.StatusOK, "/blog/sub/b.md": http.StatusNotFound, "/blog/inner/b.md": http.StatusNotFound} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Equal(t, code, w.Code, url)
+	}
+}
+
+func TestGenPath(t *testing.T) {
+	root := newResolverTree(t)
+	config := &pkg.Config{GEN_PATH: root + "/blog", BLOG_ROUTER: "/blog"}
+	path, err := pkg.GenPath("/blog/sub/", config)
+	assert.Nil(t, err)
+	assert.Equal(t, root+"/blog/sub/index.html", path)
+	path, err = pkg.GenPath("/blog/sub/b.md", config)
+	assert.Nil(t, err)
+	assert.Equal(t, root+"/blog/sub/b.html", path)
+	_, err = pkg.GenPath("/blog/../x.html", config)
+	assert.NotNil(t, err)
+	_, err = pkg.GenPath("/blog/outer/x.md", config)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,160 @@
+package eb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/internal"
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpSearcherV1(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "k", r.URL.Query().Get("key"))
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		// the first call fails and should be retried
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		assert.Equal(t, "Bearer tk", r.Header.Get("Authorization"))
+		assert.Equal(t, "v", r.Header.Get("X-Test"))
+		var req pkg.HttpSearchRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "a&b", req.Query)
+		assert.Equal(t, 2, req.Offset)
+		assert.Equal(t, []string{"blog/hide.md", "blog/private.md"}, req.Exclude)
+		json.NewEncoder(w).Encode(pkg.HttpSearchResponse{Version: 1, Results: []pkg.SearchResult{
+			{Path: "blog/a.md", Title: "A", Score: 1},
+			{Path: "blog/private.md"},
+		}})
+	}))
+	defer server.Close()
+	config := &pkg.Config{BLOG_PATH: "blog", HIDE_PATHS: []string{"blog/hide.md"}, PRIVATE_PATHS: []string{"blog/private.md"}}
+	hide := pkg.NewBlogIgnorer().AddPatterns(config.HIDE_PATHS...)
+	private := pkg.NewBlogIgnorer().AddPatterns(config.PRIVATE_PATHS...)
+	plugin := pkg.SearcherPlugin{Name: "http", Type: "url", Url: server.URL + "/search?key=k", Version: 1, Token: "tk", Headers: map[string]string{"X-Test": "v"}, Retries: 1}
+	searcher := pkg.NewSearcherByPlugin(plugin, hide, private, config)
+	results, err := searcher.(pkg.DetailSearcher).SearchDetail("a&b", 5, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []pkg.SearchResult{{Path: "blog/a.md", Title: "A", Score: 1}}, results)
+	assert.True(t, searcher.(pkg.HealthChecker).Healthy())
+}
+
+func TestHttpSearcherLegacy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "a&b c", r.URL.Query().Get("keyword"))
+		assert.Equal(t, "5", r.URL.Query().Get("num"))
+		// 插件 url 中已有的参数
+		assert.Equal(t, "k", r.URL.Query().Get("key"))
+		json.NewEncoder(w).Encode([]string{"blog/a.md"})
+	}))
+	defer server.Close()
+	plugin := pkg.SearcherPlugin{Name: "http", Type: "url", Url: server.URL + "/search?key=k"}
+	searcher := pkg.NewSearcherByPlugin(plugin, pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer(), &pkg.Config{})
+	results, err := searcher.Search("a&b c", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"blog/a.md"}, results)
+}
+
+func TestHttpSearcherLegacyOffset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		num, _ := strconv.Atoi(r.URL.Query().Get("num"))
+		paths := []string{"blog/1.md", "blog/2.md", "blog/3.md", "blog/4.md", "blog/5.md"}
+		json.NewEncoder(w).Encode(paths[:min(num, len(paths))])
+	}))
+	defer server.Close()
+	plugin := pkg.SearcherPlugin{Name: "http", Type: "url", Url: server.URL}
+	searcher := pkg.NewSearcherByPlugin(plugin, pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer(), &pkg.Config{}).(pkg.DetailSearcher)
+	page := func(num, offset int) []string {
+		results, err := searcher.SearchDetail("x", num, offset)
+		assert.Nil(t, err)
+		paths := []string{}
+		for _, result := range results {
+			paths = append(paths, result.Path)
+		}
+		return paths
+	}
+	assert.Equal(t, []string{"blog/1.md", "blog/2.md"}, page(2, 0))
+	assert.Equal(t, []string{"blog/3.md", "blog/4.md"}, page(2, 2))
+	assert.Equal(t, []string{"blog/5.md"}, page(2, 4))
+	assert.Equal(t, []string{}, page(2, 6))
+}
+
+func TestHttpSearcherHealthNotBlocking(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+	plugin := pkg.SearcherPlugin{Name: "http", Type: "url", Url: server.URL, Version: 1}
+	checker := pkg.NewSearcherByPlugin(plugin, pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer(), &pkg.Config{}).(pkg.HealthChecker)
+	go checker.Healthy()
+	time.Sleep(100 * time.Millisecond)
+	// 探测期间的调用者不等待,使用上一次的结果
+	done := make(chan bool)
+	go func() { done <- checker.Healthy() }()
+	select {
+	case healthy := <-done:
+		assert.False(t, healthy)
+	case <-time.After(time.Second):
+		t.Fatal("Healthy blocked behind a probe in progress")
+	}
+}
+
+func TestSearchersHealthConcurrent(t *testing.T) {
+	// 每个插件的 /health 都很慢
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	root := filepath.ToSlash(t.TempDir())
+	blog := root + "/blog"
+	assert.Nil(t, os.MkdirAll(blog, os.ModePerm))
+	config := pkg.ParseConfig([]byte(`blog_path = "` + blog + `"
+app_data_path = "` + root + `/data"
+render_command = "cat"
+not_gen = true
+`))
+	for _, name := range []string{"p1", "p2", "p3", "p4"} {
+		config.SEARCH_PLUGINS = append(config.SEARCH_PLUGINS, pkg.SearcherPlugin{Name: name, Type: "url", Url: server.URL + "/" + name, Version: 1})
+	}
+	spider := &fakeSpider{paths: []string{blog}, changed: make(chan string)}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	shutdown := internal.RouteApp(r, config, spider, nil)
+	defer shutdown()
+	start := time.Now()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/searchers", nil))
+	assert.Less(t, time.Since(start), 1500*time.Millisecond)
+	var searchers []struct {
+		Type    string `json:"type"`
+		Healthy bool   `json:"healthy"`
+	}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &searchers))
+	healthy := 0
+	for _, searcher := range searchers {
+		if searcher.Healthy {
+			healthy++
+		}
+	}
+	assert.Equal(t, len(searchers), healthy)
+	assert.Len(t, searchers, 8)
+}
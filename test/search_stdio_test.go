@@ -0,0 +1,95 @@
+//go:build !windows
+
+package eb
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const stdioPlugin = `#!/bin/sh
+while read -r line; do
+	id=$(echo "$line" | sed -n 's/.*"id":\([0-9]*\).*/\1/p')
+	case "$line" in
+	*'"query":"crash"'*) exit 1 ;;
+	*'"method":"init"'*) echo "{\"jsonrpc\":\"2.0\",\"id\":$id,\"result\":{}}" ;;
+	*'"method":"search"'*) echo "{\"jsonrpc\":\"2.0\",\"id\":$id,\"result\":{\"results\":[{\"path\":\"blog/a.md\",\"title\":\"A\"},{\"path\":\"blog/private.md\"}]}}" ;;
+	esac
+done
+`
+
+func waitHealthy(searcher pkg.Searcher, healthy bool) bool {
+	for i := 0; i < 100; i++ {
+		if searcher.(pkg.HealthChecker).Healthy() == healthy {
+			return true
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return false
+}
+
+func TestStdioSearcher(t *testing.T) {
+	script := t.TempDir() + "/plugin.sh"
+	assert.Nil(t, os.WriteFile(script, []byte(stdioPlugin), 0755))
+	config := &pkg.Config{BLOG_PATH: "blog", PRIVATE_PATHS: []string{"blog/private.md"}}
+	private := pkg.NewBlogIgnorer().AddPatterns(config.PRIVATE_PATHS...)
+	plugin := pkg.SearcherPlugin{Name: "stdio", Type: "stdio", Command: script, Timeout: "2s"}
+	searcher := pkg.NewSearcherByPlugin(plugin, pkg.NewBlogIgnorer(), private, config)
+	assert.True(t, waitHealthy(searcher, true))
+	results, err := searcher.(pkg.DetailSearcher).SearchDetail("go", 10, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []pkg.SearchResult{{Path: "blog/a.md", Title: "A"}}, results)
+	searcher.(pkg.ChangeNotifier).NotifyChanged("blog/a.md")
+	// the plugin is restarted after crash
+	_, err = searcher.Search("crash", 10)
+	assert.NotNil(t, err)
+	assert.True(t, waitHealthy(searcher, false))
+	assert.True(t, waitHealthy(searcher, true))
+	paths, err := searcher.Search("go", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"blog/a.md"}, paths)
+	assert.Nil(t, searcher.(interface{ Close() error }).Close())
+	assert.False(t, searcher.(pkg.HealthChecker).Healthy())
+}
+
+// 初始化后不再读取 stdin 的插件
+const stalledPlugin = `#!/bin/sh
+read -r line
+id=$(echo "$line" | sed -n 's/.*"id":\([0-9]*\).*/\1/p')
+echo "{\"jsonrpc\":\"2.0\",\"id\":$id,\"result\":{}}"
+exec sleep 30
+`
+
+func TestStdioSearcherStalledWrite(t *testing.T) {
+	script := t.TempDir() + "/plugin.sh"
+	assert.Nil(t, os.WriteFile(script, []byte(stalledPlugin), 0755))
+	plugin := pkg.SearcherPlugin{Name: "stdio", Type: "stdio", Command: script, Timeout: "300ms"}
+	searcher := pkg.NewSearcherByPlugin(plugin, pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer(), &pkg.Config{BLOG_PATH: "blog"})
+	defer searcher.(interface{ Close() error }).Close()
+	assert.True(t, waitHealthy(searcher, true))
+	// 写满管道的缓冲区后写入会阻塞,超时后插件被杀死
+	path := "blog/" + strings.Repeat("x", 100*1024) + ".md"
+	start := time.Now()
+	searcher.(pkg.ChangeNotifier).NotifyChanged(path)
+	searcher.(pkg.ChangeNotifier).NotifyChanged(path)
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.True(t, waitHealthy(searcher, false))
+}
+
+// 不响应 init 的插件被杀死并回收,不会阻塞
+func TestStdioSearcherInitTimeout(t *testing.T) {
+	script := t.TempDir() + "/plugin.sh"
+	assert.Nil(t, os.WriteFile(script, []byte("#!/bin/sh\nexec sleep 30\n"), 0755))
+	plugin := pkg.SearcherPlugin{Name: "stdio", Type: "stdio", Command: script, Timeout: "200ms"}
+	searcher := pkg.NewSearcherByPlugin(plugin, pkg.NewBlogIgnorer(), pkg.NewBlogIgnorer(), &pkg.Config{BLOG_PATH: "blog"})
+	time.Sleep(500 * time.Millisecond)
+	assert.False(t, searcher.(pkg.HealthChecker).Healthy())
+	start := time.Now()
+	assert.Nil(t, searcher.(interface{ Close() error }).Close())
+	assert.Less(t, time.Since(start), 3*time.Second)
+}
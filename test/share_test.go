@@ -0,0 +1,42 @@
+package eb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/easy-projects/easyblog/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShareLink(t *testing.T) {
+	shares := pkg.NewShareLinker(t.TempDir())
+	link, err := shares.Create("/blog/private.md", time.Hour, 2)
+	assert.Nil(t, err)
+	assert.False(t, shares.Verify("/blog/other.md", link.Token))
+	assert.False(t, shares.Verify("/blog/private.md", link.Token+"x"))
+	assert.True(t, shares.Verify("/blog/private.md", link.Token))
+	assert.True(t, shares.Verify("/blog/private.md", link.Token))
+	// max views used up
+	assert.False(t, shares.Verify("/blog/private.md", link.Token))
+}
+
+func TestShareLinkRotate(t *testing.T) {
+	shares := pkg.NewShareLinker(t.TempDir())
+	link, err := shares.Create("/blog/private.md", time.Hour, 0)
+	assert.Nil(t, err)
+	links, err := shares.List()
+	assert.Nil(t, err)
+	assert.Len(t, links, 1)
+	assert.Nil(t, shares.Rotate())
+	assert.False(t, shares.Verify("/blog/private.md", link.Token))
+	links, err = shares.List()
+	assert.Nil(t, err)
+	assert.Len(t, links, 0)
+}
+
+func TestShareLinkExpired(t *testing.T) {
+	shares := pkg.NewShareLinker(t.TempDir())
+	link, err := shares.Create("/blog/private.md", -time.Second, 0)
+	assert.Nil(t, err)
+	assert.False(t, shares.Verify("/blog/private.md", link.Token))
+}